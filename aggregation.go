@@ -1,103 +1,109 @@
 package series
 
 import (
+	"sort"
+
 	"github.com/WinPooh32/series/math"
 )
 
 // AggregateFunc is applied aggregation function.
-type AggregateFunc func(data Data) DType
+type AggregateFunc[T Float] func(data Data[T]) T
 
 // Mean returns mean of data's values.
-func Mean(data Data) DType {
+func Mean[T Float](data Data[T]) T {
 	var (
 		count int
-		sum   DType
+		sum   T
 		items = data.Values()
 	)
-	for _, v := range items {
-		if IsNA(v) {
+	for i, v := range items {
+		if data.isNA(i) {
 			continue
 		}
 		sum += v
 		count++
 	}
 	if count == 0 {
-		return math.NaN()
+		return math.NaN[T]()
 	}
-	return sum / DType(count)
+	return sum / T(count)
 }
 
 // Sum returns sum of data's values.
-func Sum(data Data) DType {
+func Sum[T Float](data Data[T]) T {
 	var (
-		sum   DType
+		sum   T
 		count int
 		items = data.Values()
 	)
-	for _, v := range items {
-		if IsNA(v) {
+	for i, v := range items {
+		if data.isNA(i) {
 			continue
 		}
 		sum += v
 		count++
 	}
 	if count == 0 {
-		return math.NaN()
+		return math.NaN[T]()
 	}
 	return sum
 }
 
 // Min returns minimum value.
-func Min(data Data) DType {
+func Min[T Float](data Data[T]) T {
 	var (
-		min   DType = maxFloat
+		min   T
 		count int
 		items = data.Values()
 	)
-	for _, v := range items {
-		if IsNA(v) {
+	for i, v := range items {
+		if data.isNA(i) {
 			continue
 		}
-		if v < min {
+		if count == 0 || v < min {
 			min = v
 		}
 		count++
 	}
 	if count == 0 {
-		return math.NaN()
+		return math.NaN[T]()
 	}
 	return min
 }
 
 // Max returns maximum value.
-func Max(data Data) DType {
+func Max[T Float](data Data[T]) T {
 	var (
-		max   DType = -maxFloat
+		max   T
 		count int
 		items = data.Values()
 	)
-	for _, v := range items {
-		if IsNA(v) {
+	for i, v := range items {
+		if data.isNA(i) {
 			continue
 		}
-		if v > max {
+		if count == 0 || v > max {
 			max = v
 		}
 		count++
 	}
 	if count == 0 {
-		return math.NaN()
+		return math.NaN[T]()
 	}
 	return max
 }
 
 // Median returns median value of series.
 // Linear interpolation is used for odd length.
-func Median(data Data) DType {
+func Median[T Float](data Data[T]) T {
 	values := data.Values()
 
+	if data.HasValidity() {
+		values = data.validValues(nil)
+	}
+
 	if len(values) == 0 {
-		return math.NaN()
+		return math.NaN[T]()
 	}
 
 	if len(values) == 1 {
@@ -114,17 +120,17 @@ func Median(data Data) DType {
 
 // Argmin returns offset of the smallest value of series data.
 // If the minimum is achieved in multiple locations, the first row position is returned.
-func Argmin(data Data) int {
+func Argmin[T Float](data Data[T]) int {
 	var (
-		min   DType = maxFloat
-		pos   int   = -1
-		items       = data.Values()
+		min   T
+		pos   int = -1
+		items     = data.Values()
 	)
 	for i, v := range items {
-		if IsNA(v) {
+		if data.isNA(i) {
 			continue
 		}
-		if v < min {
+		if pos < 0 || v < min {
 			min = v
 			pos = i
 		}
@@ -134,17 +140,17 @@ func Argmin(data Data) int {
 
 // Argmax returns offset of the biggest value of series data.
 // If the maximum is achieved in multiple locations, the first row position is returned.
-func Argmax(data Data) int {
+func Argmax[T Float](data Data[T]) int {
 	var (
-		max   DType = -maxFloat
-		pos   int   = -1
-		items       = data.Values()
+		max   T
+		pos   int = -1
+		items     = data.Values()
 	)
 	for i, v := range items {
-		if IsNA(v) {
+		if data.isNA(i) {
 			continue
 		}
-		if v > max {
+		if pos < 0 || v > max {
 			max = v
 			pos = i
 		}
@@ -155,9 +161,9 @@ func Argmax(data Data) int {
 // Variance returns variance of values.
 // Ddof - Delta Degrees of Freedom. The divisor used in calculations is N - ddof,
 // where N represents the number of elements.
-func Variance(data Data, mean DType, ddof int) DType {
+func Variance[T Float](data Data[T], mean T, ddof int) T {
 	if data.Len() == 0 || IsNA(mean) {
-		return math.NaN()
+		return math.NaN[T]()
 	}
 
 	if ddof < 0 || ddof >= data.Len() {
@@ -165,14 +171,14 @@ func Variance(data Data, mean DType, ddof int) DType {
 	}
 
 	var (
-		dev   DType
+		dev   T
 		count int
 
 		values = data.Values()
 	)
 
-	for _, v := range values {
-		if IsNA(v) {
+	for i, v := range values {
+		if data.isNA(i) {
 			continue
 		}
 		d := v - mean
@@ -181,41 +187,40 @@ func Variance(data Data, mean DType, ddof int) DType {
 	}
 
 	if count-ddof < 0 {
-		return math.NaN()
+		return math.NaN[T]()
 	}
 
-	return dev / DType(count-ddof)
+	return dev / T(count-ddof)
 }
 
 // Std returns standard deviation.
 // Ddof - Delta Degrees of Freedom. The divisor used in calculations is N - ddof,
 // where N represents the number of elements.
-func Std(data Data, mean DType, ddof int) DType {
+func Std[T Float](data Data[T], mean T, ddof int) T {
 	return math.Sqrt(Variance(data, mean, ddof))
 }
 
-func First(data Data) DType {
+func First[T Float](data Data[T]) T {
 	values := data.Values()
-	for _, v := range values {
-		if !IsNA(v) {
+	for i, v := range values {
+		if !data.isNA(i) {
 			return v
 		}
 	}
-	return math.NaN()
+	return math.NaN[T]()
 }
 
-func Last(data Data) DType {
+func Last[T Float](data Data[T]) T {
 	values := data.Values()
 	for i := len(values) - 1; i >= 0; i-- {
-		v := values[i]
-		if !IsNA(v) {
-			return v
+		if !data.isNA(i) {
+			return values[i]
 		}
 	}
-	return math.NaN()
+	return math.NaN[T]()
 }
 
-func Skew(data Data) DType {
+func Skew[T Float](data Data[T]) T {
 	count := countNotNA(data)
 
 	mean := Sum(data) / count
@@ -224,17 +229,24 @@ func Skew(data Data) DType {
 	m3 := sumAdjustedPow3(data, mean)
 
 	// fix floating point error.
-	m2 = fpZero(m2, Eps)
-	m3 = fpZero(m3, Eps)
+	scale := maxAbs(data)
+	m2 = fpZeroMoment(m2, count, scale, 2)
+	m3 = fpZeroMoment(m3, count, scale, 3)
 
 	if m2 == 0 || m3 == 0 {
 		return 0
 	}
 
 	if count < 3 {
-		return math.NaN()
+		return math.NaN[T]()
 	}
 
+	return skewness(count, m2, m3)
+}
+
+// skewness returns unbiased skewness of count values
+// by sums of squared and cubed deviations from the mean.
+func skewness[T Float](count, m2, m3 T) T {
 	g1 := m3 / (math.Sqrt(m2) * m2)
 
 	G1 := ((count * math.Sqrt(count-1)) * g1) / (count - 2)
@@ -242,25 +254,78 @@ func Skew(data Data) DType {
 	return G1
 }
 
-func countNotNA(data Data) DType {
+// Kurt returns unbiased excess kurtosis of values (Fisher's definition).
+func Kurt[T Float](data Data[T]) T {
+	count := countNotNA(data)
+
+	if count < 4 {
+		return math.NaN[T]()
+	}
+
+	mean := Sum(data) / count
+
+	m2 := sumAdjustedPow2(data, mean)
+	m4 := sumAdjustedPow4(data, mean)
+
+	// fix floating point error.
+	m2 = fpZeroMoment(m2, count, maxAbs(data), 2)
+
+	if m2 == 0 {
+		return 0
+	}
+
+	return kurtosis(count, m2, m4)
+}
+
+// fpZeroMoment zeroes sum m of p-th powers of deviations from the mean of count values
+// if it doesn't exceed the rounding error of deviations of values not greater than scale by magnitude.
+// So the sum of constant values is zero, while the spread of values is kept at any scale.
+func fpZeroMoment[T Float](m, count, scale T, p int) T {
+	return fpZero(m, count*math.Pow(Eps[T]()*scale, T(p)))
+}
+
+// maxAbs returns the greatest magnitude of valid values.
+func maxAbs[T Float](data Data[T]) T {
+	var max T
+	for i, v := range data.values {
+		if data.isNA(i) {
+			continue
+		}
+		if v = math.Abs(v); v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+// kurtosis returns unbiased excess kurtosis of count values
+// by sums of squared and 4th power deviations from the mean.
+func kurtosis[T Float](count, m2, m4 T) T {
+	n := count
+	a := (n + 1) * n * (n - 1) / ((n - 2) * (n - 3))
+	b := 3 * (n - 1) * (n - 1) / ((n - 2) * (n - 3))
+	return a*m4/(m2*m2) - b
+}
+
+func countNotNA[T Float](data Data[T]) T {
 	count := 0
 	items := data.values
-	for _, v := range items {
-		if !IsNA(v) {
+	for i := range items {
+		if !data.isNA(i) {
 			count++
 		}
 	}
-	return DType(count)
+	return T(count)
 }
 
-func sumAdjustedPow2(data Data, mean DType) DType {
+func sumAdjustedPow2[T Float](data Data[T], mean T) T {
 	var (
-		sum   DType
+		sum   T
 		count int
 		items = data.Values()
 	)
-	for _, v := range items {
-		if IsNA(v) {
+	for i, v := range items {
+		if data.isNA(i) {
 			continue
 		}
 		v -= mean
@@ -268,19 +333,19 @@ func sumAdjustedPow2(data Data, mean DType) DType {
 		count++
 	}
 	if count == 0 {
-		return math.NaN()
+		return math.NaN[T]()
 	}
 	return sum
 }
 
-func sumAdjustedPow3(data Data, mean DType) DType {
+func sumAdjustedPow3[T Float](data Data[T], mean T) T {
 	var (
-		sum   DType
+		sum   T
 		count int
 		items = data.Values()
 	)
-	for _, v := range items {
-		if IsNA(v) {
+	for i, v := range items {
+		if data.isNA(i) {
 			continue
 		}
 		v -= mean
@@ -288,7 +353,163 @@ func sumAdjustedPow3(data Data, mean DType) DType {
 		count++
 	}
 	if count == 0 {
-		return math.NaN()
+		return math.NaN[T]()
+	}
+	return sum
+}
+
+func sumAdjustedPow4[T Float](data Data[T], mean T) T {
+	var (
+		sum   T
+		count int
+		items = data.Values()
+	)
+	for i, v := range items {
+		if data.isNA(i) {
+			continue
+		}
+		v -= mean
+		sum += v * v * v * v
+		count++
+	}
+	if count == 0 {
+		return math.NaN[T]()
 	}
 	return sum
 }
+
+// Count returns count of not NA values.
+func Count[T Float](data Data[T]) T {
+	return countNotNA(data)
+}
+
+// Prod returns product of data's values.
+func Prod[T Float](data Data[T]) T {
+	var (
+		prod  T = 1
+		count int
+		items = data.Values()
+	)
+	for i, v := range items {
+		if data.isNA(i) {
+			continue
+		}
+		prod *= v
+		count++
+	}
+	if count == 0 {
+		return math.NaN[T]()
+	}
+	return prod
+}
+
+// NUnique returns count of distinct not NA values.
+func NUnique[T Float](data Data[T]) T {
+	var (
+		seen  = make(map[T]struct{}, data.Len())
+		nan   bool
+		items = data.Values()
+	)
+	for i, v := range items {
+		if data.isNA(i) {
+			continue
+		}
+		if v != v {
+			// NaN is not equal to itself, so it can't be a map key.
+			nan = true
+			continue
+		}
+		seen[v] = struct{}{}
+	}
+	count := len(seen)
+	if nan {
+		count++
+	}
+	return T(count)
+}
+
+// Var returns variance of values with the mean computed from data.
+// Ddof - Delta Degrees of Freedom. The divisor used in calculations is N - ddof,
+// where N represents the number of not NA elements.
+// NaN is returned if N is not greater than ddof.
+func Var[T Float](data Data[T], ddof int) T {
+	if ddof < 0 {
+		panic("ddof must be positive value!")
+	}
+	if int(countNotNA(data)) <= ddof {
+		return math.NaN[T]()
+	}
+	return Variance(data, Mean(data), ddof)
+}
+
+// Sem returns standard error of the mean.
+// Ddof - Delta Degrees of Freedom, see Var.
+func Sem[T Float](data Data[T], ddof int) T {
+	return math.Sqrt(Var(data, ddof) / countNotNA(data))
+}
+
+// QuantileInterpolation is the method of picking quantile value between two data points.
+type QuantileInterpolation int
+
+const (
+	// QuantileLinear interpolates linearly between two data points.
+	QuantileLinear QuantileInterpolation = iota
+	// QuantileLower takes the lower data point.
+	QuantileLower
+	// QuantileHigher takes the higher data point.
+	QuantileHigher
+	// QuantileNearest takes the nearest data point, ties are resolved to the even position.
+	QuantileNearest
+	// QuantileMidpoint takes mean of two data points.
+	QuantileMidpoint
+)
+
+// Quantile returns q-th quantile of not NA values, q must be in [0, 1] range.
+func Quantile[T Float](data Data[T], q T, interpolation QuantileInterpolation) T {
+	values := data.validValues(nil)
+	sort.Sort(FloatSlice[T](values))
+	return quantileSorted(values, q, interpolation)
+}
+
+// quantileSorted returns q-th quantile of sorted values.
+func quantileSorted[T Float](sorted []T, q T, interpolation QuantileInterpolation) T {
+	return quantileAt(len(sorted), func(i int) T { return sorted[i] }, q, interpolation)
+}
+
+// quantileAt computes quantile of n sorted values accessed by offset.
+func quantileAt[T Float](n int, at func(i int) T, q T, interpolation QuantileInterpolation) T {
+	mustBeQuantile(q)
+
+	if n == 0 {
+		return math.NaN[T]()
+	}
+
+	pos := q * T(n-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	frac := pos - T(lo)
+
+	switch interpolation {
+	case QuantileLinear:
+		if frac == 0 {
+			return at(lo)
+		}
+		return at(lo) + (at(hi)-at(lo))*frac
+	case QuantileLower:
+		return at(lo)
+	case QuantileHigher:
+		return at(hi)
+	case QuantileNearest:
+		return at(int(math.RoundToEven(pos)))
+	case QuantileMidpoint:
+		return (at(lo) + at(hi)) / 2
+	default:
+		panic("unknown quantile interpolation method")
+	}
+}
+
+func mustBeQuantile[T Float](q T) {
+	if q != q || q < 0 || q > 1 {
+		panic("q must be in [0, 1] range!")
+	}
+}
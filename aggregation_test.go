@@ -8,23 +8,23 @@ import (
 )
 
 func BenchmarkPow3_2(b *testing.B) {
-	var s DType = 0
+	var s float64 = 0
 	for i := 0; i < b.N; i++ {
-		s += math.Pow(DType(i), 1.5)
+		s += math.Pow(float64(i), 1.5)
 	}
 	fmt.Println(s)
 }
 
 func BenchmarkSqrtMulasPow3_2(b *testing.B) {
-	var s DType = 0
+	var s float64 = 0
 	for i := 0; i < b.N; i++ {
-		s += math.Sqrt(DType(i)) * DType(i)
+		s += math.Sqrt(float64(i)) * float64(i)
 	}
 	fmt.Println(s)
 }
 
 func BenchmarkSumRef(b *testing.B) {
-	var s DType = 0
+	var s float64 = 0
 	for i := 0; i < b.N; i++ {
 		s += 1.0
 	}
@@ -33,80 +33,80 @@ func BenchmarkSumRef(b *testing.B) {
 
 func TestMean(t *testing.T) {
 	type args struct {
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name string
 		args args
-		want DType
+		want float64
 	}{
 		{
 			name: "empty",
 			args: args{
-				data: MakeData(1, []int64{}, []DType{}),
+				data: MakeData(1, []int64{}, []float64{}),
 			},
 			want: NaN,
 		},
 		{
 			name: "all NaN",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3}, []DType{NaN, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3}, []float64{NaN, NaN, NaN}),
 			},
 			want: NaN,
 		},
 		{
 			name: "one value",
 			args: args{
-				data: MakeData(1, []int64{1}, []DType{2}),
+				data: MakeData(1, []int64{1}, []float64{2}),
 			},
 			want: 2,
 		},
 		{
 			name: "simple",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{2, 2, 6, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{2, 2, 6, 6}),
 			},
 			want: 4,
 		},
 		{
 			name: "NaNs leading",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{NaN, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{NaN, NaN, 2, 6}),
 			},
 			want: 4,
 		},
 		{
 			name: "nonNaNs leading",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{2, 6, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{2, 6, NaN, NaN}),
 			},
 			want: 4,
 		},
 		{
 			name: "NaN at mid 1",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []DType{2, 6, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{2, 6, NaN, 2, 6}),
 			},
 			want: 4,
 		},
 		{
 			name: "NaN at mid 2",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{2, 6, NaN, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{2, 6, NaN, NaN, 2, 6}),
 			},
 			want: 4,
 		},
 		{
 			name: "NaN bounds",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{NaN, 2, 6, 2, 6, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{NaN, 2, 6, 2, 6, NaN}),
 			},
 			want: 4,
 		},
 		{
 			name: "NaN bounds 2",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7, 8}, []DType{NaN, NaN, 2, 6, 2, 6, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7, 8}, []float64{NaN, NaN, 2, 6, 2, 6, NaN, NaN}),
 			},
 			want: 4,
 		},
@@ -123,80 +123,80 @@ func TestMean(t *testing.T) {
 
 func TestSum(t *testing.T) {
 	type args struct {
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name string
 		args args
-		want DType
+		want float64
 	}{
 		{
 			name: "empty",
 			args: args{
-				data: MakeData(1, []int64{}, []DType{}),
+				data: MakeData(1, []int64{}, []float64{}),
 			},
 			want: NaN,
 		},
 		{
 			name: "all NaN",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3}, []DType{NaN, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3}, []float64{NaN, NaN, NaN}),
 			},
 			want: NaN,
 		},
 		{
 			name: "one value",
 			args: args{
-				data: MakeData(1, []int64{1}, []DType{2}),
+				data: MakeData(1, []int64{1}, []float64{2}),
 			},
 			want: 2,
 		},
 		{
 			name: "simple",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{2, 2, 6, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{2, 2, 6, 6}),
 			},
 			want: 16,
 		},
 		{
 			name: "NaNs leading",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{NaN, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{NaN, NaN, 2, 6}),
 			},
 			want: 8,
 		},
 		{
 			name: "nonNaNs leading",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{2, 6, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{2, 6, NaN, NaN}),
 			},
 			want: 8,
 		},
 		{
 			name: "NaN at mid 1",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []DType{2, 6, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{2, 6, NaN, 2, 6}),
 			},
 			want: 16,
 		},
 		{
 			name: "NaN at mid 2",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{2, 6, NaN, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{2, 6, NaN, NaN, 2, 6}),
 			},
 			want: 16,
 		},
 		{
 			name: "NaN bounds",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{NaN, 2, 6, 2, 6, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{NaN, 2, 6, 2, 6, NaN}),
 			},
 			want: 16,
 		},
 		{
 			name: "NaN bounds 2",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7, 8}, []DType{NaN, NaN, 2, 6, 2, 6, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7, 8}, []float64{NaN, NaN, 2, 6, 2, 6, NaN, NaN}),
 			},
 			want: 16,
 		},
@@ -213,80 +213,80 @@ func TestSum(t *testing.T) {
 
 func TestMin(t *testing.T) {
 	type args struct {
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name string
 		args args
-		want DType
+		want float64
 	}{
 		{
 			name: "empty",
 			args: args{
-				data: MakeData(1, []int64{}, []DType{}),
+				data: MakeData(1, []int64{}, []float64{}),
 			},
 			want: NaN,
 		},
 		{
 			name: "all NaN",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3}, []DType{NaN, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3}, []float64{NaN, NaN, NaN}),
 			},
 			want: NaN,
 		},
 		{
 			name: "one value",
 			args: args{
-				data: MakeData(1, []int64{1}, []DType{2}),
+				data: MakeData(1, []int64{1}, []float64{2}),
 			},
 			want: 2,
 		},
 		{
 			name: "simple",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{2, 2, 6, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{2, 2, 6, 6}),
 			},
 			want: 2,
 		},
 		{
 			name: "NaNs leading",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{NaN, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{NaN, NaN, 2, 6}),
 			},
 			want: 2,
 		},
 		{
 			name: "nonNaNs leading",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{2, 6, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{2, 6, NaN, NaN}),
 			},
 			want: 2,
 		},
 		{
 			name: "NaN at mid 1",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []DType{2, 6, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{2, 6, NaN, 2, 6}),
 			},
 			want: 2,
 		},
 		{
 			name: "NaN at mid 2",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{2, 6, NaN, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{2, 6, NaN, NaN, 2, 6}),
 			},
 			want: 2,
 		},
 		{
 			name: "NaN bounds",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{NaN, 2, 6, 2, 6, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{NaN, 2, 6, 2, 6, NaN}),
 			},
 			want: 2,
 		},
 		{
 			name: "NaN bounds 2",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7, 8}, []DType{NaN, NaN, 2, 6, 2, 6, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7, 8}, []float64{NaN, NaN, 2, 6, 2, 6, NaN, NaN}),
 			},
 			want: 2,
 		},
@@ -303,80 +303,80 @@ func TestMin(t *testing.T) {
 
 func TestMax(t *testing.T) {
 	type args struct {
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name string
 		args args
-		want DType
+		want float64
 	}{
 		{
 			name: "empty",
 			args: args{
-				data: MakeData(1, []int64{}, []DType{}),
+				data: MakeData(1, []int64{}, []float64{}),
 			},
 			want: NaN,
 		},
 		{
 			name: "all NaN",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3}, []DType{NaN, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3}, []float64{NaN, NaN, NaN}),
 			},
 			want: NaN,
 		},
 		{
 			name: "one value",
 			args: args{
-				data: MakeData(1, []int64{1}, []DType{2}),
+				data: MakeData(1, []int64{1}, []float64{2}),
 			},
 			want: 2,
 		},
 		{
 			name: "simple",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{2, 2, 6, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{2, 2, 6, 6}),
 			},
 			want: 6,
 		},
 		{
 			name: "NaNs leading",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{NaN, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{NaN, NaN, 2, 6}),
 			},
 			want: 6,
 		},
 		{
 			name: "nonNaNs leading",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{2, 6, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{2, 6, NaN, NaN}),
 			},
 			want: 6,
 		},
 		{
 			name: "NaN at mid 1",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []DType{2, 6, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{2, 6, NaN, 2, 6}),
 			},
 			want: 6,
 		},
 		{
 			name: "NaN at mid 2",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{2, 6, NaN, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{2, 6, NaN, NaN, 2, 6}),
 			},
 			want: 6,
 		},
 		{
 			name: "NaN bounds",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{NaN, 2, 6, 2, 6, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{NaN, 2, 6, 2, 6, NaN}),
 			},
 			want: 6,
 		},
 		{
 			name: "NaN bounds 2",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7, 8}, []DType{NaN, NaN, 2, 6, 2, 6, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7, 8}, []float64{NaN, NaN, 2, 6, 2, 6, NaN, NaN}),
 			},
 			want: 6,
 		},
@@ -393,45 +393,45 @@ func TestMax(t *testing.T) {
 
 func TestMed(t *testing.T) {
 	type args struct {
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name string
 		args args
-		want DType
+		want float64
 	}{
 		{
 			name: "len = 0",
 			args: args{
-				data: MakeData(1, []int64{}, []DType{}),
+				data: MakeData(1, []int64{}, []float64{}),
 			},
 			want: NaN,
 		},
 		{
 			name: "len = 1",
 			args: args{
-				data: MakeData(1, []int64{1}, []DType{1}),
+				data: MakeData(1, []int64{1}, []float64{1}),
 			},
 			want: 1,
 		},
 		{
 			name: "even len = 4",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{1, 2, 3, 4}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{1, 2, 3, 4}),
 			},
 			want: 2.5,
 		},
 		{
 			name: "odd len = 5",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []DType{1, 2, 3, 4, 5}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{1, 2, 3, 4, 5}),
 			},
 			want: 3,
 		},
 		{
 			name: "even len = 4, with negative values",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{-1, -3, 3, 4}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{-1, -3, 3, 4}),
 			},
 			want: 0,
 		},
@@ -448,7 +448,7 @@ func TestMed(t *testing.T) {
 
 func TestArgmin(t *testing.T) {
 	type args struct {
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name string
@@ -458,70 +458,70 @@ func TestArgmin(t *testing.T) {
 		{
 			name: "empty",
 			args: args{
-				data: MakeData(1, []int64{}, []DType{}),
+				data: MakeData(1, []int64{}, []float64{}),
 			},
 			want: -1,
 		},
 		{
 			name: "all NaN",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3}, []DType{NaN, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3}, []float64{NaN, NaN, NaN}),
 			},
 			want: -1,
 		},
 		{
 			name: "one value",
 			args: args{
-				data: MakeData(1, []int64{1}, []DType{2}),
+				data: MakeData(1, []int64{1}, []float64{2}),
 			},
 			want: 0,
 		},
 		{
 			name: "simple",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{2, 2, 6, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{2, 2, 6, 6}),
 			},
 			want: 0,
 		},
 		{
 			name: "NaNs leading",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{NaN, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{NaN, NaN, 2, 6}),
 			},
 			want: 2,
 		},
 		{
 			name: "nonNaNs leading",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{2, 6, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{2, 6, NaN, NaN}),
 			},
 			want: 0,
 		},
 		{
 			name: "NaN at mid 1",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []DType{2, 6, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{2, 6, NaN, 2, 6}),
 			},
 			want: 0,
 		},
 		{
 			name: "NaN at mid 2",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{2, 6, NaN, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{2, 6, NaN, NaN, 2, 6}),
 			},
 			want: 0,
 		},
 		{
 			name: "NaN bounds",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{NaN, 2, 6, 2, 6, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{NaN, 2, 6, 2, 6, NaN}),
 			},
 			want: 1,
 		},
 		{
 			name: "NaN bounds 2",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7, 8}, []DType{NaN, NaN, 2, 6, 2, 6, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7, 8}, []float64{NaN, NaN, 2, 6, 2, 6, NaN, NaN}),
 			},
 			want: 2,
 		},
@@ -538,7 +538,7 @@ func TestArgmin(t *testing.T) {
 
 func TestArgmax(t *testing.T) {
 	type args struct {
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name string
@@ -548,70 +548,70 @@ func TestArgmax(t *testing.T) {
 		{
 			name: "empty",
 			args: args{
-				data: MakeData(1, []int64{}, []DType{}),
+				data: MakeData(1, []int64{}, []float64{}),
 			},
 			want: -1,
 		},
 		{
 			name: "all NaN",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3}, []DType{NaN, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3}, []float64{NaN, NaN, NaN}),
 			},
 			want: -1,
 		},
 		{
 			name: "one value",
 			args: args{
-				data: MakeData(1, []int64{1}, []DType{2}),
+				data: MakeData(1, []int64{1}, []float64{2}),
 			},
 			want: 0,
 		},
 		{
 			name: "simple",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{2, 2, 6, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{2, 2, 6, 6}),
 			},
 			want: 2,
 		},
 		{
 			name: "NaNs leading",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{NaN, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{NaN, NaN, 2, 6}),
 			},
 			want: 3,
 		},
 		{
 			name: "nonNaNs leading",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{2, 6, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{2, 6, NaN, NaN}),
 			},
 			want: 1,
 		},
 		{
 			name: "NaN at mid 1",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []DType{2, 6, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{2, 6, NaN, 2, 6}),
 			},
 			want: 1,
 		},
 		{
 			name: "NaN at mid 2",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{2, 6, NaN, NaN, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{2, 6, NaN, NaN, 2, 6}),
 			},
 			want: 1,
 		},
 		{
 			name: "NaN bounds",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{NaN, 2, 6, 2, 6, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{NaN, 2, 6, 2, 6, NaN}),
 			},
 			want: 2,
 		},
 		{
 			name: "NaN bounds 2",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7, 8}, []DType{NaN, NaN, 2, 6, 2, 6, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7, 8}, []float64{NaN, NaN, 2, 6, 2, 6, NaN, NaN}),
 			},
 			want: 3,
 		},
@@ -625,22 +625,47 @@ func TestArgmax(t *testing.T) {
 	}
 }
 
+func TestMinMax_Inf(t *testing.T) {
+	// Infinities are valid values under the validity bitmap.
+	inf := math.Inf[float64](1)
+
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"min +inf", Min(MakeDataValid(1, []int64{1, 2}, []float64{inf, inf}, makeBitmap(true, true))), inf},
+		{"max -inf", Max(MakeDataValid(1, []int64{1}, []float64{-inf}, makeBitmap(true))), -inf},
+		{"min -inf", Min(MakeDataValid(1, []int64{1, 2}, []float64{1, -inf}, makeBitmap(true, true))), -inf},
+		{"argmin +inf", float64(Argmin(MakeDataValid(1, []int64{1, 2}, []float64{inf, inf}, makeBitmap(true, true)))), 0},
+		{"argmax -inf", float64(Argmax(MakeDataValid(1, []int64{1, 2}, []float64{42, -inf}, makeBitmap(false, true)))), 1},
+		{"min empty", Min(MakeDataValid(1, []int64{1}, []float64{inf}, makeBitmap(false))), NaN},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want && !(math.IsNaN(tt.got) && math.IsNaN(tt.want)) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
 func TestStd(t *testing.T) {
 	type args struct {
-		data Data
-		mean DType
+		data Data[float64]
+		mean float64
 		ddof int
 	}
 	tests := []struct {
 		name      string
 		args      args
-		want      DType
+		want      float64
 		wantPainc bool
 	}{
 		{
 			name: "empty",
 			args: args{
-				data: MakeData(1, []int64{}, []DType{}),
+				data: MakeData(1, []int64{}, []float64{}),
 				mean: NaN,
 				ddof: 0,
 			},
@@ -650,7 +675,7 @@ func TestStd(t *testing.T) {
 		{
 			name: "all NaN",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3}, []DType{NaN, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3}, []float64{NaN, NaN, NaN}),
 				mean: NaN,
 				ddof: 0,
 			},
@@ -660,7 +685,7 @@ func TestStd(t *testing.T) {
 		{
 			name: "one NaN",
 			args: args{
-				data: MakeData(1, []int64{1}, []DType{NaN}),
+				data: MakeData(1, []int64{1}, []float64{NaN}),
 			},
 			want:      NaN,
 			wantPainc: false,
@@ -668,7 +693,7 @@ func TestStd(t *testing.T) {
 		{
 			name: "ddof=1 mean is NaN",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{1.61, 1.87, 1.49, 2.01}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{1.61, 1.87, 1.49, 2.01}),
 				mean: NaN,
 				ddof: 1,
 			},
@@ -678,7 +703,7 @@ func TestStd(t *testing.T) {
 		{
 			name: "ddof=1",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{1.61, 1.87, 1.49, 2.01}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{1.61, 1.87, 1.49, 2.01}),
 				mean: 1.745,
 				ddof: 1,
 			},
@@ -688,7 +713,7 @@ func TestStd(t *testing.T) {
 		{
 			name: "ddof=0",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{1.61, 1.87, 1.49, 2.01}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{1.61, 1.87, 1.49, 2.01}),
 				mean: 1.745,
 				ddof: 0,
 			},
@@ -698,7 +723,7 @@ func TestStd(t *testing.T) {
 		{
 			name: "ddof<0",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{1.61, 1.87, 1.49, 2.01}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{1.61, 1.87, 1.49, 2.01}),
 				mean: 1.745,
 				ddof: -1,
 			},
@@ -725,59 +750,59 @@ func TestStd(t *testing.T) {
 
 func TestFirst(t *testing.T) {
 	type args struct {
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name string
 		args args
-		want DType
+		want float64
 	}{
 		{
 			name: "empty",
 			args: args{
-				data: MakeData(1, []int64{}, []DType{}),
+				data: MakeData(1, []int64{}, []float64{}),
 			},
 			want: NaN,
 		},
 		{
 			name: "all NaN",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3}, []DType{NaN, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3}, []float64{NaN, NaN, NaN}),
 			},
 			want: NaN,
 		},
 		{
 			name: "last nonNa",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{NaN, NaN, NaN, 4}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{NaN, NaN, NaN, 4}),
 			},
 			want: 4,
 		},
 		{
 			name: "first nonNa",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{4, NaN, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{4, NaN, NaN, NaN}),
 			},
 			want: 4,
 		},
 		{
 			name: "mid nonNa",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{NaN, 4, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{NaN, 4, NaN, NaN}),
 			},
 			want: 4,
 		},
 		{
 			name: "mid nonNa 2",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{NaN, 2, 4, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{NaN, 2, 4, NaN}),
 			},
 			want: 2,
 		},
 		{
 			name: "all nonNa",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{1, 2, 3, 4}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{1, 2, 3, 4}),
 			},
 			want: 1,
 		},
@@ -794,59 +819,59 @@ func TestFirst(t *testing.T) {
 
 func TestLast(t *testing.T) {
 	type args struct {
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name string
 		args args
-		want DType
+		want float64
 	}{
 		{
 			name: "empty",
 			args: args{
-				data: MakeData(1, []int64{}, []DType{}),
+				data: MakeData(1, []int64{}, []float64{}),
 			},
 			want: NaN,
 		},
 		{
 			name: "all NaN",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3}, []DType{NaN, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3}, []float64{NaN, NaN, NaN}),
 			},
 			want: NaN,
 		},
 		{
 			name: "last nonNa",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{NaN, NaN, NaN, 4}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{NaN, NaN, NaN, 4}),
 			},
 			want: 4,
 		},
 		{
 			name: "first nonNa",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{4, NaN, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{4, NaN, NaN, NaN}),
 			},
 			want: 4,
 		},
 		{
 			name: "mid nonNa",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{NaN, 4, NaN, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{NaN, 4, NaN, NaN}),
 			},
 			want: 4,
 		},
 		{
 			name: "mid nonNa 2",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{NaN, 2, 4, NaN}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{NaN, 2, 4, NaN}),
 			},
 			want: 4,
 		},
 		{
 			name: "all nonNa",
 			args: args{
-				data: MakeData(1, []int64{1, 2, 3, 4}, []DType{1, 2, 3, 4}),
+				data: MakeData(1, []int64{1, 2, 3, 4}, []float64{1, 2, 3, 4}),
 			},
 			want: 4,
 		},
@@ -863,38 +888,38 @@ func TestLast(t *testing.T) {
 
 func TestSkew(t *testing.T) {
 	type args struct {
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name string
 		args args
-		want DType
+		want float64
 	}{
 		{
 			name: "simple_with_nan",
 			args: args{
-				data: MakeValues([]DType{1, NaN, 1, 2}),
+				data: MakeValues([]float64{1, NaN, 1, 2}),
 			},
 			want: 1.7320508075688787,
 		},
 		{
 			name: "simple_with",
 			args: args{
-				data: MakeValues([]DType{1, 1, 2}),
+				data: MakeValues([]float64{1, 1, 2}),
 			},
 			want: 1.7320508075688787,
 		},
 		{
 			name: "simple_with3",
 			args: args{
-				data: MakeValues([]DType{1, 1, 1, 1, 2}),
+				data: MakeValues([]float64{1, 1, 1, 1, 2}),
 			},
 			want: 2.2360679774997902,
 		},
 		{
 			name: "simple_2233",
 			args: args{
-				data: MakeValues([]DType{2, 2, 3, 3}),
+				data: MakeValues([]float64{2, 2, 3, 3}),
 			},
 			want: 0,
 		},
@@ -908,3 +933,131 @@ func TestSkew(t *testing.T) {
 		})
 	}
 }
+
+func TestKurt(t *testing.T) {
+	tests := []struct {
+		name string
+		data Data[float64]
+		want float64
+	}{
+		{"linear", MakeValues([]float64{1, 2, 3, 4}), -1.2},
+		{"with nan", MakeValues([]float64{1, NaN, 2, 3, 4}), -1.2},
+		{"outlier", MakeValues([]float64{1, 1, 1, 1, 10}), 5},
+		{"constant", MakeValues([]float64{2, 2, 2, 2}), 0},
+		{"too short", MakeValues([]float64{1, 2, 3}), NaN},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Kurt(tt.data)
+			if !fpEq(got, tt.want, 1e-9) && !(math.IsNaN(got) && math.IsNaN(tt.want)) {
+				t.Errorf("Kurt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	data := MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{4, NaN, 1, 3, 2})
+
+	tests := []struct {
+		name          string
+		q             float64
+		interpolation QuantileInterpolation
+		want          float64
+	}{
+		{"linear", 0.4, QuantileLinear, 2.2},
+		{"lower", 0.4, QuantileLower, 2},
+		{"higher", 0.4, QuantileHigher, 3},
+		{"nearest", 0.4, QuantileNearest, 2},
+		{"nearest tie to even", 0.5, QuantileNearest, 3},
+		{"midpoint", 0.4, QuantileMidpoint, 2.5},
+		{"min", 0, QuantileLinear, 1},
+		{"max", 1, QuantileLinear, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Quantile(data, tt.q, tt.interpolation); !fpEq(got, tt.want, EpsFp64) {
+				t.Errorf("Quantile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := Quantile(MakeData(1, []int64{1}, []float64{NaN}), 0.5, QuantileLinear); !math.IsNaN(got) {
+		t.Errorf("Quantile() = %v, want %v", got, NaN)
+	}
+
+	for _, q := range []float64{NaN, -0.1, 1.1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Quantile(q=%v) must panic", q)
+				}
+			}()
+			Quantile(data, q, QuantileLinear)
+		}()
+	}
+}
+
+func TestVar(t *testing.T) {
+	data := MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{1.61, 1.87, NaN, 1.49, 2.01})
+
+	tests := []struct {
+		name string
+		fn   AggregateFunc[float64]
+		want float64
+	}{
+		{"var ddof=1", func(d Data[float64]) float64 { return Var(d, 1) }, 0.0563666},
+		{"var ddof=0", func(d Data[float64]) float64 { return Var(d, 0) }, 0.0422750},
+		{"var ddof=count", func(d Data[float64]) float64 { return Var(d, 4) }, NaN},
+		{"sem", func(d Data[float64]) float64 { return Sem(d, 1) }, 0.1187083},
+		{"count", Count[float64], 4},
+		{"prod", Prod[float64], 1.61 * 1.87 * 1.49 * 2.01},
+		{"nunique", NUnique[float64], 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fn(data)
+			if !(IsNA(got) && IsNA(tt.want)) && !fpEq(got, tt.want, EpsFp32) {
+				t.Errorf("AggregateFunc() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoments_Offset(t *testing.T) {
+	// Spread of values is small compared with their mean.
+	centered := []float64{0, .001, .002, .007, .003}
+	offset := make([]float64, len(centered))
+	for i, v := range centered {
+		offset[i] = 1e6 + v
+	}
+	data := MakeValues(offset)
+	index := []int64{0, 1, 2, 3, 4}
+
+	wantKurt := Kurt(MakeValues(centered))
+	wantSkew := Skew(MakeValues(centered))
+
+	tests := []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"kurt", Kurt(data), wantKurt},
+		{"skew", Skew(data), wantSkew},
+		{"rolling kurt", MakeData(1, index, offset).Rolling(5).Kurt().At(-1), wantKurt},
+		{"expanding kurt", MakeData(1, index, offset).Expanding(4).Kurt().At(-1), wantKurt},
+		{"constant kurt", Kurt(MakeValues([]float64{1e6 + .1, 1e6 + .1, 1e6 + .1, 1e6 + .1})), 0},
+		{"constant skew", Skew(MakeValues([]float64{1e6 + .1, 1e6 + .1, 1e6 + .1})), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !fpEq(tt.got, tt.want, 1e-4) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if !fpEq(wantKurt, 2.021, 1e-3) {
+		t.Errorf("Kurt() = %v, want %v", wantKurt, 2.021)
+	}
+}
@@ -0,0 +1,228 @@
+package series
+
+// Bitmap is the validity bitmap of series values in the Apache Arrow layout.
+// Bit i (least significant bit numbering) is set when value i is valid
+// and cleared when value i is missing.
+//
+// Zero Bitmap means the absence of a bitmap:
+// missing values are marked by NaN or ±Inf sentinels then.
+type Bitmap struct {
+	bits   []byte
+	offset int
+	length int
+}
+
+// MakeBitmap makes bitmap of length bits, all of them are set to valid argument.
+func MakeBitmap(length int, valid bool) Bitmap {
+	bits := make([]byte, (length+7)/8)
+	if valid {
+		for i := range bits {
+			bits[i] = 0xFF
+		}
+	}
+	return Bitmap{
+		bits:   bits,
+		offset: 0,
+		length: length,
+	}
+}
+
+// MakeBitmapBytes makes bitmap over the existing Arrow validity buffer.
+// The buffer is not copied.
+func MakeBitmapBytes(bits []byte, offset, length int) Bitmap {
+	if offset < 0 || length < 0 {
+		panic("offset and length must be positive values")
+	}
+	if (offset+length+7)/8 > len(bits) {
+		panic("bitmap buffer is too short")
+	}
+	if bits == nil {
+		bits = []byte{}
+	}
+	return Bitmap{
+		bits:   bits,
+		offset: offset,
+		length: length,
+	}
+}
+
+// bitmapOf makes bitmap of values where NaN values are marked as missing.
+func bitmapOf[T Float](values []T) Bitmap {
+	b := MakeBitmap(len(values), true)
+	for i, v := range values {
+		if v != v {
+			b.Set(i, false)
+		}
+	}
+	return b
+}
+
+// sentinelBitmapOf makes bitmap of values where NaN and ±Inf values are marked as missing.
+func sentinelBitmapOf[T Float](values []T) Bitmap {
+	b := MakeBitmap(len(values), true)
+	for i, v := range values {
+		if IsNA(v) {
+			b.Set(i, false)
+		}
+	}
+	return b
+}
+
+// IsZero reports whether the bitmap is absent.
+func (b Bitmap) IsZero() bool {
+	return b.bits == nil
+}
+
+// Len returns count of bits.
+func (b Bitmap) Len() int {
+	return b.length
+}
+
+// Offset returns offset of the first bit at the underlying buffer.
+func (b Bitmap) Offset() int {
+	return b.offset
+}
+
+// Bytes returns the underlying buffer.
+func (b Bitmap) Bytes() []byte {
+	return b.bits
+}
+
+// Get reports whether value at i position is valid.
+func (b Bitmap) Get(i int) bool {
+	if i < 0 || i >= b.length {
+		panic("bitmap index out of range")
+	}
+	i += b.offset
+	return b.bits[i>>3]&(1<<(i&7)) != 0
+}
+
+// Set marks value at i position as valid or missing.
+func (b Bitmap) Set(i int, valid bool) {
+	if i < 0 || i >= b.length {
+		panic("bitmap index out of range")
+	}
+	i += b.offset
+	if valid {
+		b.bits[i>>3] |= 1 << (i & 7)
+	} else {
+		b.bits[i>>3] &^= 1 << (i & 7)
+	}
+}
+
+// CountValid returns count of set bits.
+func (b Bitmap) CountValid() int {
+	count := 0
+	for i := 0; i < b.length; i++ {
+		if b.Get(i) {
+			count++
+		}
+	}
+	return count
+}
+
+// Slice makes slice of bits sharing the underlying buffer.
+func (b Bitmap) Slice(l, r int) Bitmap {
+	if b.IsZero() {
+		return b
+	}
+	if l < 0 || r < l || r > b.length {
+		panic("bitmap slice bounds out of range")
+	}
+	return Bitmap{
+		bits:   b.bits,
+		offset: b.offset + l,
+		length: r - l,
+	}
+}
+
+// Clone makes full copy of bits with zero offset.
+func (b Bitmap) Clone() Bitmap {
+	if b.IsZero() {
+		return b
+	}
+	clone := MakeBitmap(b.length, false)
+	for i := 0; i < b.length; i++ {
+		if b.Get(i) {
+			clone.Set(i, true)
+		}
+	}
+	return clone
+}
+
+// Append appends bit to the end of bitmap.
+func (b Bitmap) Append(valid bool) Bitmap {
+	if b.IsZero() {
+		b.bits = []byte{}
+	}
+	if n := b.offset + b.length; n>>3 >= len(b.bits) {
+		b.bits = append(b.bits, 0)
+	}
+	b.length++
+	b.Set(b.length-1, valid)
+	return b
+}
+
+// Resize resizes bitmap, new bits are set to valid argument.
+func (b Bitmap) Resize(newLen int, valid bool) Bitmap {
+	if newLen <= b.length {
+		b.length = newLen
+		return b
+	}
+	for b.length < newLen {
+		b = b.Append(valid)
+	}
+	return b
+}
+
+// Fill sets all bits to valid argument.
+func (b Bitmap) Fill(valid bool) {
+	for i := 0; i < b.length; i++ {
+		b.Set(i, valid)
+	}
+}
+
+// And clears bits which are cleared at r.
+func (b Bitmap) And(r Bitmap) {
+	if b.length != r.length {
+		panic("sizes of bitmaps must be equal")
+	}
+	for i := 0; i < b.length; i++ {
+		if !r.Get(i) {
+			b.Set(i, false)
+		}
+	}
+}
+
+// Reverse reverses order of bits.
+func (b Bitmap) Reverse() Bitmap {
+	for l, r := 0, b.length-1; l < r; l, r = l+1, r-1 {
+		b.Swap(l, r)
+	}
+	return b
+}
+
+// Swap swaps bits at i and j positions.
+func (b Bitmap) Swap(i, j int) {
+	vi, vj := b.Get(i), b.Get(j)
+	if vi != vj {
+		b.Set(i, vj)
+		b.Set(j, vi)
+	}
+}
+
+// Shift shifts bits by specified periods count.
+// Freed bits are marked as missing.
+func (b Bitmap) Shift(periods int) Bitmap {
+	switch {
+	case periods > 0:
+		for i := b.length - 1; i >= 0; i-- {
+			b.Set(i, i-periods >= 0 && b.Get(i-periods))
+		}
+	case periods < 0:
+		for i := 0; i < b.length; i++ {
+			b.Set(i, i-periods < b.length && b.Get(i-periods))
+		}
+	}
+	return b
+}
@@ -0,0 +1,171 @@
+package series
+
+import (
+	"testing"
+
+	"github.com/WinPooh32/series/math"
+)
+
+func makeBitmap(valid ...bool) Bitmap {
+	b := MakeBitmap(len(valid), true)
+	for i, v := range valid {
+		b.Set(i, v)
+	}
+	return b
+}
+
+func TestBitmap_Slice(t *testing.T) {
+	b := makeBitmap(true, false, true, true, false, false, true, true, false, true)
+
+	s := b.Slice(3, 10)
+	want := []bool{true, false, false, true, true, false, true}
+
+	if s.Len() != len(want) {
+		t.Fatalf("Bitmap.Len() = %v, want %v", s.Len(), len(want))
+	}
+	for i, v := range want {
+		if got := s.Get(i); got != v {
+			t.Errorf("Bitmap.Get(%d) = %v, want %v", i, got, v)
+		}
+	}
+	if got := s.Clone().CountValid(); got != 4 {
+		t.Errorf("Bitmap.CountValid() = %v, want %v", got, 4)
+	}
+}
+
+func TestData_Validity_Aggregation(t *testing.T) {
+	inf := math.Inf[float64](1)
+
+	data := MakeDataValid(
+		1,
+		[]int64{1, 2, 3, 4},
+		[]float64{1, inf, 100, 3},
+		makeBitmap(true, true, false, true),
+	)
+
+	tests := []struct {
+		name string
+		fn   AggregateFunc[float64]
+		want float64
+	}{
+		{"sum", Sum[float64], inf},
+		{"max", Max[float64], inf},
+		{"min", Min[float64], 1},
+		{"first", First[float64], 1},
+		{"last", Last[float64], 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fn(data); got != tt.want {
+				t.Errorf("AggregateFunc() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := Mean(data.Slice(2, 4)); got != 3 {
+		t.Errorf("Mean() = %v, want %v", got, 3)
+	}
+}
+
+func TestData_Validity_Fill(t *testing.T) {
+	inf := math.Inf[float64](1)
+
+	tests := []struct {
+		name string
+		fn   func(Data[float64]) Data[float64]
+		want []float64
+	}{
+		{"fillna", func(d Data[float64]) Data[float64] { return d.Fillna(0) }, []float64{1, 0, inf, 0, 5}},
+		{"pad", Data[float64].Pad, []float64{1, 1, inf, inf, 5}},
+		{"lerp", Data[float64].Lerp, []float64{1, 2, 3, 4, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := MakeDataValid(
+				1,
+				[]int64{1, 2, 3, 4, 5},
+				[]float64{1, 42, inf, 42, 5},
+				makeBitmap(true, false, true, false, true),
+			)
+			if tt.name == "lerp" {
+				data.Set(2, 3)
+			}
+
+			got := tt.fn(data)
+			if got.HasNA() {
+				t.Errorf("Data.HasNA() = true, want false")
+			}
+			for i, v := range tt.want {
+				if got.At(i) != v {
+					t.Errorf("Data.At(%d) = %v, want %v", i, got.At(i), v)
+				}
+			}
+		})
+	}
+}
+
+func TestData_Validity_Shrink(t *testing.T) {
+	inf := math.Inf[float64](-1)
+
+	data := MakeDataValid(
+		1,
+		[]int64{1, 2, 3, 4, 5},
+		[]float64{1, 2, inf, 4, NaN},
+		makeBitmap(true, false, true, false, true),
+	)
+	want := MakeDataValid(
+		1,
+		[]int64{1, 3, 5},
+		[]float64{1, inf, NaN},
+		makeBitmap(true, true, true),
+	)
+
+	got := data.Shrink()
+
+	if !got.IndexEquals(want) {
+		t.Fatalf("Data.Shrink() = %v, want %v", got, want)
+	}
+	if got.At(1) != inf || !math.IsNaN(got.At(2)) || got.HasNA() {
+		t.Errorf("Data.Shrink() = %v, want %v", got, want)
+	}
+}
+
+func TestData_Validity_Rolling(t *testing.T) {
+	data := MakeDataValid(
+		1,
+		[]int64{1, 2, 3, 4, 5},
+		[]float64{1, 2, 3, 4, 5},
+		makeBitmap(true, true, false, true, true),
+	)
+	want := MakeDataValid(
+		1,
+		[]int64{1, 2, 3, 4, 5},
+		[]float64{0, 3, 2, 4, 9},
+		makeBitmap(false, true, true, true, true),
+	)
+
+	if got := data.Rolling(2).Sum(); !got.Equals(want, EpsFp64) {
+		t.Errorf("Window.Sum() = %v, want %v", got, want)
+	}
+}
+
+func TestData_Validity_Resample(t *testing.T) {
+	inf := math.Inf[float64](1)
+
+	data := MakeDataValid(
+		1,
+		[]int64{1, 2, 3, 4, 5, 6},
+		[]float64{1, 2, inf, 4, 5, 6},
+		makeBitmap(true, true, true, true, false, false),
+	)
+	want := MakeDataValid(
+		2,
+		[]int64{1, 3, 5},
+		[]float64{3, inf, 0},
+		makeBitmap(true, true, false),
+	)
+
+	if got := data.Resample(2, OriginStart).Sum(); !got.Equals(want, EpsFp64) {
+		t.Errorf("Resampler.Sum() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,233 @@
+package series
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type calendarUnit int
+
+const (
+	calendarNone calendarUnit = iota
+	calendarWeek
+	calendarMonth
+	calendarQuarter
+	calendarYear
+	calendarBusinessDay
+)
+
+// nanosPerMonth is the average length of the Gregorian month.
+const nanosPerMonth = int64(2629746 * time.Second)
+
+// CalendarFreq is the variable length calendar period of resampling.
+// Periods begin at local midnight of the resampling location and consist of whole days.
+// Periods anchored at the end (W, ME) are labeled by their last day,
+// it matches pandas bins closed at the right side after the end of the last day.
+type CalendarFreq struct {
+	unit    calendarUnit
+	n       int
+	anchor  time.Weekday
+	labelAt bool // label group by the last day of the period.
+}
+
+// Weeks returns period of n weeks ending on anchor weekday (W-MON for Monday),
+// the period is labeled by its last day.
+func Weeks(n int, anchor time.Weekday) CalendarFreq {
+	if anchor < time.Sunday || anchor > time.Saturday {
+		panic("anchor must be a valid weekday")
+	}
+	return makeCalendarFreq(calendarWeek, n, anchor, true)
+}
+
+// MonthStart returns period of n months labeled by the first day of month (MS).
+func MonthStart(n int) CalendarFreq {
+	return makeCalendarFreq(calendarMonth, n, 0, false)
+}
+
+// MonthEnd returns period of n months labeled by the last day of month (ME).
+func MonthEnd(n int) CalendarFreq {
+	return makeCalendarFreq(calendarMonth, n, 0, true)
+}
+
+// QuarterStart returns period of n quarters labeled by the first day of quarter (QS).
+func QuarterStart(n int) CalendarFreq {
+	return makeCalendarFreq(calendarQuarter, n, 0, false)
+}
+
+// YearStart returns period of n years labeled by the first day of year (YS).
+func YearStart(n int) CalendarFreq {
+	return makeCalendarFreq(calendarYear, n, 0, false)
+}
+
+// BusinessDays returns period of n business days (B).
+// Weekend values are joined to the preceding Friday.
+func BusinessDays(n int) CalendarFreq {
+	return makeCalendarFreq(calendarBusinessDay, n, 0, false)
+}
+
+func makeCalendarFreq(unit calendarUnit, n int, anchor time.Weekday, labelAt bool) CalendarFreq {
+	if n <= 0 {
+		panic("count of calendar periods must be greater than zero")
+	}
+	return CalendarFreq{
+		unit:    unit,
+		n:       n,
+		anchor:  anchor,
+		labelAt: labelAt,
+	}
+}
+
+var weekdayAliases = [...]string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+// ParseCalendarFreq parses pandas-like frequency alias with optional multiplier:
+// "W" (same as "W-SUN"), "W-MON" ... "W-SAT", "MS", "ME", "QS", "YS", "B"; for example "2MS".
+func ParseCalendarFreq(s string) (CalendarFreq, error) {
+	alias := strings.TrimLeft(s, "0123456789")
+
+	n := 1
+	if prefix := s[:len(s)-len(alias)]; prefix != "" {
+		var err error
+		if n, err = strconv.Atoi(prefix); err != nil || n <= 0 {
+			return CalendarFreq{}, fmt.Errorf("series: invalid calendar frequency multiplier %q", s)
+		}
+	}
+
+	switch alias {
+	case "W":
+		return Weeks(n, time.Sunday), nil
+	case "MS":
+		return MonthStart(n), nil
+	case "ME":
+		return MonthEnd(n), nil
+	case "QS":
+		return QuarterStart(n), nil
+	case "YS":
+		return YearStart(n), nil
+	case "B":
+		return BusinessDays(n), nil
+	}
+
+	if strings.HasPrefix(alias, "W-") {
+		for day, name := range weekdayAliases {
+			if alias[2:] == name {
+				return Weeks(n, time.Weekday(day)), nil
+			}
+		}
+	}
+
+	return CalendarFreq{}, fmt.Errorf("series: unknown calendar frequency %q", s)
+}
+
+// String returns pandas-like alias of the frequency.
+func (f CalendarFreq) String() string {
+	var alias string
+
+	switch f.unit {
+	case calendarWeek:
+		alias = "W-" + weekdayAliases[f.anchor]
+	case calendarMonth:
+		if f.labelAt {
+			alias = "ME"
+		} else {
+			alias = "MS"
+		}
+	case calendarQuarter:
+		alias = "QS"
+	case calendarYear:
+		alias = "YS"
+	case calendarBusinessDay:
+		alias = "B"
+	default:
+		return ""
+	}
+
+	if f.n == 1 {
+		return alias
+	}
+	return strconv.Itoa(f.n) + alias
+}
+
+// IsZero reports whether the frequency is not set.
+func (f CalendarFreq) IsZero() bool {
+	return f.unit == calendarNone
+}
+
+// nominal returns average length of the period.
+func (f CalendarFreq) nominal() int64 {
+	var length int64
+
+	switch f.unit {
+	case calendarWeek:
+		length = 7 * nanosPerDay
+	case calendarMonth:
+		length = nanosPerMonth
+	case calendarQuarter:
+		length = 3 * nanosPerMonth
+	case calendarYear:
+		length = 12 * nanosPerMonth
+	case calendarBusinessDay:
+		length = nanosPerDay
+	}
+
+	return int64(f.n) * length
+}
+
+// ordinal returns number of the unit period containing t counting from 1970-01-01.
+func (f CalendarFreq) ordinal(t time.Time) int64 {
+	months := int64(t.Year()-1970)*12 + int64(t.Month()-1)
+
+	switch f.unit {
+	case calendarWeek:
+		// 1970-01-01 is Thursday.
+		return floorDiv(civilDay(t)+int64(time.Thursday-f.firstDay()), 7)
+	case calendarMonth:
+		return months
+	case calendarQuarter:
+		return floorDiv(months, 3)
+	case calendarYear:
+		return int64(t.Year() - 1970)
+	case calendarBusinessDay:
+		// Shift days to make Monday the first day of week.
+		day := civilDay(t) + int64(time.Thursday-time.Monday)
+		week, weekday := floorDiv(day, 7), day-floorDiv(day, 7)*7
+		if weekday > 4 {
+			weekday = 4
+		}
+		return week*5 + weekday
+	default:
+		panic("unknown calendar frequency")
+	}
+}
+
+// start returns local midnight of the first day of ordinal unit period.
+func (f CalendarFreq) start(ordinal int64, loc *time.Location) time.Time {
+	var (
+		month = int64(0)
+		day   = int64(0)
+	)
+
+	switch f.unit {
+	case calendarWeek:
+		day = ordinal*7 - int64(time.Thursday-f.firstDay())
+	case calendarMonth:
+		month = ordinal
+	case calendarQuarter:
+		month = ordinal * 3
+	case calendarYear:
+		month = ordinal * 12
+	case calendarBusinessDay:
+		week, weekday := floorDiv(ordinal, 5), ordinal-floorDiv(ordinal, 5)*5
+		day = week*7 + weekday - int64(time.Thursday-time.Monday)
+	default:
+		panic("unknown calendar frequency")
+	}
+
+	return time.Date(1970, time.Month(1+month), int(1+day), 0, 0, 0, 0, loc)
+}
+
+// firstDay returns the first weekday of the week period, the day after the anchor.
+func (f CalendarFreq) firstDay() time.Weekday {
+	return (f.anchor + 1) % 7
+}
@@ -0,0 +1,190 @@
+package series
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCalendarFreq(t *testing.T) {
+	tests := []struct {
+		alias   string
+		want    CalendarFreq
+		wantErr bool
+	}{
+		{"W", Weeks(1, time.Sunday), false},
+		{"W-MON", Weeks(1, time.Monday), false},
+		{"2W-FRI", Weeks(2, time.Friday), false},
+		{"MS", MonthStart(1), false},
+		{"3ME", MonthEnd(3), false},
+		{"QS", QuarterStart(1), false},
+		{"YS", YearStart(1), false},
+		{"5B", BusinessDays(5), false},
+		{"W-XYZ", CalendarFreq{}, true},
+		{"0MS", CalendarFreq{}, true},
+		{"D", CalendarFreq{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.alias, func(t *testing.T) {
+			got, err := ParseCalendarFreq(tt.alias)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCalendarFreq() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseCalendarFreq() = %v, want %v", got, tt.want)
+			}
+			if !tt.wantErr && got.String() != tt.alias && tt.alias != "W" {
+				t.Errorf("CalendarFreq.String() = %v, want %v", got.String(), tt.alias)
+			}
+		})
+	}
+}
+
+func TestData_ResampleCalendar(t *testing.T) {
+	date := func(year int, month time.Month, day int) int64 {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).UnixNano()
+	}
+
+	// Daily values from Wednesday 2023-01-25 till Sunday 2023-04-02.
+	var (
+		index  []int64
+		values []float64
+	)
+	for ts := date(2023, 1, 25); ts <= date(2023, 4, 2); ts += nanosPerDay {
+		index = append(index, ts)
+		values = append(values, 1)
+	}
+	data := MakeData(nanosPerDay, index, values)
+
+	tests := []struct {
+		name   string
+		freq   CalendarFreq
+		origin ResampleOrigin
+		index  []int64
+		want   []float64
+	}{
+		{
+			"W-MON",
+			Weeks(1, time.Monday),
+			OriginEpoch,
+			[]int64{date(2023, 1, 30), date(2023, 2, 6), date(2023, 2, 13), date(2023, 2, 20), date(2023, 2, 27), date(2023, 3, 6), date(2023, 3, 13), date(2023, 3, 20), date(2023, 3, 27), date(2023, 4, 3)},
+			[]float64{6, 7, 7, 7, 7, 7, 7, 7, 7, 6},
+		},
+		{
+			"MS",
+			MonthStart(1),
+			OriginEpoch,
+			[]int64{date(2023, 1, 1), date(2023, 2, 1), date(2023, 3, 1), date(2023, 4, 1)},
+			[]float64{7, 28, 31, 2},
+		},
+		{
+			"ME",
+			MonthEnd(1),
+			OriginEpoch,
+			[]int64{date(2023, 1, 31), date(2023, 2, 28), date(2023, 3, 31), date(2023, 4, 30)},
+			[]float64{7, 28, 31, 2},
+		},
+		{
+			"2MS from epoch",
+			MonthStart(2),
+			OriginEpoch,
+			[]int64{date(2023, 1, 1), date(2023, 3, 1)},
+			[]float64{35, 33},
+		},
+		{
+			"2MS from start",
+			MonthStart(2),
+			OriginStart,
+			[]int64{date(2023, 1, 1), date(2023, 3, 1)},
+			[]float64{35, 33},
+		},
+		{
+			"QS",
+			QuarterStart(1),
+			OriginEpoch,
+			[]int64{date(2023, 1, 1), date(2023, 4, 1)},
+			[]float64{66, 2},
+		},
+		{
+			"YS",
+			YearStart(1),
+			OriginEpoch,
+			[]int64{date(2023, 1, 1)},
+			[]float64{68},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := MakeData(tt.freq.nominal(), tt.index, tt.want)
+			if got := data.ResampleCalendar(tt.freq, tt.origin).Sum(); !got.Equals(want, EpsFp64) {
+				t.Errorf("Resampler.Sum() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestData_ResampleCalendar_WeekEnd(t *testing.T) {
+	date := func(day int, hour int) int64 {
+		return time.Date(2023, time.March, day, hour, 0, 0, 0, time.UTC).UnixNano()
+	}
+
+	// The whole anchor day belongs to the week ending on it like pandas W-<DAY>.
+	data := MakeData(
+		int64(time.Hour),
+		[]int64{date(6, 12), date(7, 0), date(12, 18), date(13, 0), date(13, 23), date(14, 0)},
+		[]float64{1, 2, 3, 4, 5, 6},
+	)
+
+	tests := []struct {
+		name  string
+		freq  CalendarFreq
+		index []int64
+		want  []float64
+	}{
+		{"W-MON", Weeks(1, time.Monday), []int64{date(6, 0), date(13, 0), date(20, 0)}, []float64{1, 14, 6}},
+		{"W", Weeks(1, time.Sunday), []int64{date(12, 0), date(19, 0)}, []float64{6, 15}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := MakeData(tt.freq.nominal(), tt.index, tt.want)
+			if got := data.ResampleCalendar(tt.freq, OriginEpoch).Sum(); !got.Equals(want, EpsFp64) {
+				t.Errorf("Resampler.Sum() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestData_ResampleCalendar_BusinessDays(t *testing.T) {
+	date := func(day int, hour int) int64 {
+		return time.Date(2023, time.March, day, hour, 0, 0, 0, time.UTC).UnixNano()
+	}
+
+	// Friday 2023-03-10 till Tuesday 2023-03-14.
+	data := MakeData(
+		int64(12*time.Hour),
+		[]int64{date(10, 0), date(10, 12), date(11, 12), date(12, 12), date(13, 0), date(14, 12)},
+		[]float64{1, 2, 3, 4, 5, 6},
+	)
+	freq := BusinessDays(1)
+
+	tests := []struct {
+		name string
+		fn   func(Resampler[float64]) Data[float64]
+		want []float64
+	}{
+		{"sum", Resampler[float64].Sum, []float64{10, 5, 6}},
+		{"mean", Resampler[float64].Mean, []float64{2.5, 5, 6}},
+		{"min", Resampler[float64].Min, []float64{1, 5, 6}},
+		{"max", Resampler[float64].Max, []float64{4, 5, 6}},
+		{"median", Resampler[float64].Median, []float64{2.5, 5, 6}},
+		{"first", Resampler[float64].First, []float64{1, 5, 6}},
+		{"last", Resampler[float64].Last, []float64{4, 5, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := MakeData(nanosPerDay, []int64{date(10, 0), date(13, 0), date(14, 0)}, tt.want)
+			if got := tt.fn(data.ResampleCalendar(freq, OriginStartDay)); !got.Equals(want, EpsFp64) {
+				t.Errorf("Resampler = %v, want %v", got, want)
+			}
+		})
+	}
+}
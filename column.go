@@ -0,0 +1,269 @@
+package series
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/WinPooh32/series/math"
+)
+
+// column is the values container shared by non-float series types.
+// Missing values are marked by the optional validity bitmap.
+// Series types embed the column, so its accessors are promoted to them.
+type column[V any] struct {
+	freq   int64
+	index  []int64
+	values []V
+	valid  Bitmap
+}
+
+func makeColumn[V any](freq int64, index []int64, values []V, valid Bitmap) column[V] {
+	if len(index) != len(values) {
+		panic("length of index and values must be equal")
+	}
+	if !valid.IsZero() && valid.Len() != len(values) {
+		panic("length of validity bitmap and values must be equal")
+	}
+	return column[V]{
+		freq:   freq,
+		index:  index,
+		values: values,
+		valid:  valid,
+	}
+}
+
+// Index returns underlying index values.
+func (c column[V]) Index() (index []int64) {
+	return c.index
+}
+
+// Values returns underlying values.
+func (c column[V]) Values() (values []V) {
+	return c.values
+}
+
+// Validity returns the validity bitmap.
+// Zero Bitmap is returned if data has no validity bitmap.
+func (c column[V]) Validity() Bitmap {
+	return c.valid
+}
+
+// Len returns size of series values.
+func (c column[V]) Len() int {
+	return len(c.values)
+}
+
+// Freq returns period length of one sample.
+func (c column[V]) Freq() int64 {
+	return c.freq
+}
+
+// At returns value at i offset.
+// i can be negative.
+func (c column[V]) At(i int) V {
+	if i < 0 {
+		i = len(c.values) + i
+	}
+	return c.values[i]
+}
+
+// IsNAAt reports whether value at i position is missing.
+// i can be negative.
+func (c column[V]) IsNAAt(i int) bool {
+	if i < 0 {
+		i = len(c.values) + i
+	}
+	return c.isNA(i)
+}
+
+func (c column[V]) isNA(i int) bool {
+	return c.valid.bits != nil && !c.valid.Get(i)
+}
+
+func (c column[V]) countValid() int {
+	if c.valid.IsZero() {
+		return len(c.values)
+	}
+	return c.valid.CountValid()
+}
+
+func (c column[V]) slice(l, r int) column[V] {
+	if l < 0 {
+		l = len(c.values) + l
+	}
+	if r < 0 {
+		r = (len(c.values) + r) + 1
+	}
+	return column[V]{
+		freq:   c.freq,
+		index:  c.index[l:r],
+		values: c.values[l:r],
+		valid:  c.valid.Slice(l, r),
+	}
+}
+
+func (c column[V]) clone() column[V] {
+	return column[V]{
+		freq:   c.freq,
+		index:  append([]int64(nil), c.index...),
+		values: append([]V(nil), c.values...),
+		valid:  c.valid.Clone(),
+	}
+}
+
+func (c column[V]) indexSort(stable bool) {
+	if stable {
+		sort.Stable(columnArgSortable[V](c))
+	} else {
+		sort.Sort(columnArgSortable[V](c))
+	}
+}
+
+func (c column[V]) indexEquals(r column[V]) bool {
+	if len(c.index) != len(r.index) {
+		return false
+	}
+	for i := range c.index {
+		if c.index[i] != r.index[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// columnEquals tests columns are equal to each other.
+// Missing values are considered to be equal.
+func columnEquals[V comparable](l, r column[V]) bool {
+	if !l.indexEquals(r) || len(l.values) != len(r.values) {
+		return false
+	}
+	for i, v := range l.values {
+		naL, naR := l.isNA(i), r.isNA(i)
+		if naL != naR || (!naL && v != r.values[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c column[V]) string(format func(v V) string) string {
+	var sb strings.Builder
+
+	sb.WriteString("[\n")
+
+	for i, x := range c.index {
+		t := time.Duration(x)
+
+		sb.WriteString("    ")
+		sb.WriteString(t.String())
+		sb.WriteString(": ")
+		if c.isNA(i) {
+			sb.WriteString("NA")
+		} else {
+			sb.WriteString(format(c.values[i]))
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("]\n")
+
+	return sb.String()
+}
+
+type columnArgSortable[V any] column[V]
+
+func (x columnArgSortable[V]) Len() int { return len(x.index) }
+
+func (x columnArgSortable[V]) Less(i, j int) bool { return x.index[i] < x.index[j] }
+
+func (x columnArgSortable[V]) Swap(i, j int) {
+	x.values[i], x.values[j] = x.values[j], x.values[i]
+	x.index[i], x.index[j] = x.index[j], x.index[i]
+	if !x.valid.IsZero() {
+		x.valid.Swap(i, j)
+	}
+}
+
+// columnAsData converts column values to series data by conv.
+// Missing values are NaN and marked by the validity bitmap.
+func columnAsData[T Float, V any](c column[V], conv func(v V) T) Data[T] {
+	values := make([]T, len(c.values))
+	for i, v := range c.values {
+		if c.isNA(i) {
+			values[i] = math.NaN[T]()
+			continue
+		}
+		values[i] = conv(v)
+	}
+	return Data[T]{
+		freq:   c.freq,
+		index:  c.index,
+		values: values,
+		valid:  c.valid.Clone(),
+	}
+}
+
+// dataAsColumn converts series data values to column by conv.
+// Missing values and values conv can't convert are marked by the validity bitmap.
+func dataAsColumn[V any, T Float](d Data[T], conv func(v T) (V, bool)) column[V] {
+	values := make([]V, len(d.values))
+	valid := MakeBitmap(len(d.values), true)
+
+	for i, v := range d.values {
+		if d.isNA(i) {
+			valid.Set(i, false)
+			continue
+		}
+		value, ok := conv(v)
+		if !ok {
+			valid.Set(i, false)
+			continue
+		}
+		values[i] = value
+	}
+
+	return makeColumn(d.freq, d.index, values, valid)
+}
+
+// resampleColumn applies agg to every resampling group of the column.
+// Groups for which agg returns false are marked as missing.
+func resampleColumn[V any, R any](c column[V], rule resampleRule, agg func(group column[V]) (R, bool)) column[R] {
+	total := rule.groupsCap(c.index, c.freq)
+
+	var (
+		index  = make([]int64, 0, total)
+		values = make([]R, 0, total)
+		valid  = MakeBitmap(0, true)
+	)
+
+	rule.groups(c.index, func(label int64, beg, end int) {
+		value, ok := agg(c.slice(beg, end))
+
+		index = append(index, label)
+		values = append(values, value)
+		valid = valid.Append(ok)
+	})
+
+	return makeColumn(rule.freq, index, values, valid)
+}
+
+// firstValid returns the first not missing value of the column.
+func firstValid[V any](c column[V]) (v V, ok bool) {
+	for i := range c.values {
+		if !c.isNA(i) {
+			return c.values[i], true
+		}
+	}
+	return v, false
+}
+
+// lastValid returns the last not missing value of the column.
+func lastValid[V any](c column[V]) (v V, ok bool) {
+	for i := len(c.values) - 1; i >= 0; i-- {
+		if !c.isNA(i) {
+			return c.values[i], true
+		}
+	}
+	return v, false
+}
@@ -10,19 +10,21 @@ import (
 )
 
 // Data is the series values container.
-type Data struct {
+type Data[T Float] struct {
 	freq   int64
 	index  []int64
-	values []DType
+	values []T
+	// valid is the optional validity bitmap of values.
+	valid Bitmap
 }
 
 // MakeData makes series data instance.
 // freq is the size of values sample.
-func MakeData(freq int64, index []int64, values []DType) Data {
+func MakeData[T Float](freq int64, index []int64, values []T) Data[T] {
 	if len(index) != len(values) {
 		panic("length of index and values must be equal")
 	}
-	return Data{
+	return Data[T]{
 		freq:   freq,
 		index:  index,
 		values: values,
@@ -31,17 +33,24 @@ func MakeData(freq int64, index []int64, values []DType) Data {
 
 // MakeValues makes vector of values without indices.
 // Any manipulations with index will cause panic or incorrect results!
-func MakeValues(values []DType) Data {
-	return Data{
+func MakeValues[T Float](values []T) Data[T] {
+	return Data[T]{
 		freq:   0,
 		index:  nil,
 		values: values,
 	}
 }
 
+// MakeDataValid makes series data instance with the validity bitmap.
+// freq is the size of values sample.
+func MakeDataValid[T Float](freq int64, index []int64, values []T, valid Bitmap) Data[T] {
+	return MakeData(freq, index, values).WithValidity(valid)
+}
+
 // String converts time series columns to string.
 // Index values are rendered as time.Duration.
-func (d Data) String() string {
+// Values marked as missing by the validity bitmap are rendered as NA.
+func (d Data[T]) String() string {
 	var sb strings.Builder
 
 	sb.WriteString("[\n")
@@ -53,7 +62,11 @@ func (d Data) String() string {
 		sb.WriteString("    ")
 		sb.WriteString(t.String())
 		sb.WriteString(": ")
-		sb.WriteString(strconv.FormatFloat(float64(y), 'f', -1, 64))
+		if d.HasValidity() && !d.valid.Get(i) {
+			sb.WriteString("NA")
+		} else {
+			sb.WriteString(strconv.FormatFloat(float64(y), 'f', -1, 64))
+		}
 		sb.WriteString("\n")
 	}
 
@@ -64,7 +77,7 @@ func (d Data) String() string {
 
 // IndexAt returns index value at i offset.
 // i can be negative.
-func (d Data) IndexAt(i int) int64 {
+func (d Data[T]) IndexAt(i int) int64 {
 	if i < 0 {
 		i = len(d.index) + i
 	}
@@ -73,7 +86,7 @@ func (d Data) IndexAt(i int) int64 {
 
 // At returns values value at i offset.
 // i can be negative.
-func (d Data) At(i int) DType {
+func (d Data[T]) At(i int) T {
 	if i < 0 {
 		i = len(d.values) + i
 	}
@@ -81,36 +94,144 @@ func (d Data) At(i int) DType {
 }
 
 // Set sets new value at i position.
+// The value is marked as valid if data has the validity bitmap.
 // i can be negative.
-func (d Data) Set(i int, v DType) {
+func (d Data[T]) Set(i int, v T) {
 	if i < 0 {
 		i = len(d.values) + i
 	}
 	d.values[i] = v
+	d.setValid(i, true)
 }
 
 // SetXY x to index, y to values at position i.
+// The value is marked as valid if data has the validity bitmap.
 // i can be negative.
-func (d Data) SetXY(i int, x int64, y DType) {
+func (d Data[T]) SetXY(i int, x int64, y T) {
 	if i < 0 {
 		i = len(d.values) + i
 	}
 	d.index[i] = x
 	d.values[i] = y
+	d.setValid(i, true)
+}
+
+// SetNA marks value at i position as missing.
+// Value is replaced by NaN if data has no validity bitmap.
+// i can be negative.
+func (d Data[T]) SetNA(i int) {
+	if i < 0 {
+		i = len(d.values) + i
+	}
+	if d.HasValidity() {
+		d.valid.Set(i, false)
+		return
+	}
+	d.values[i] = math.NaN[T]()
+}
+
+// IsNAAt reports whether value at i position is missing.
+//
+// If data has the validity bitmap, only cleared bits mean missing values,
+// so NaN and ±Inf are regular values. Otherwise NaN and ±Inf are missing values.
+//
+// i can be negative.
+func (d Data[T]) IsNAAt(i int) bool {
+	if i < 0 {
+		i = len(d.values) + i
+	}
+	return d.isNA(i)
+}
+
+// HasValidity reports whether data has the validity bitmap.
+func (d Data[T]) HasValidity() bool {
+	return !d.valid.IsZero()
+}
+
+// Validity returns the validity bitmap.
+// Zero Bitmap is returned if data has no validity bitmap.
+func (d Data[T]) Validity() Bitmap {
+	return d.valid
+}
+
+// WithValidity attaches the validity bitmap to data.
+// Zero Bitmap detaches the validity bitmap.
+func (d Data[T]) WithValidity(valid Bitmap) Data[T] {
+	if !valid.IsZero() && valid.Len() != len(d.values) {
+		panic("length of validity bitmap and values must be equal")
+	}
+	d.valid = valid
+	return d
+}
+
+// MaskNA makes the validity bitmap from NaN and ±Inf sentinel values.
+// Data which already has the validity bitmap is returned as is.
+func (d Data[T]) MaskNA() Data[T] {
+	if d.HasValidity() {
+		return d
+	}
+	d.valid = sentinelBitmapOf(d.values)
+	return d
+}
+
+// MaskNaN makes the validity bitmap from NaN values,
+// so ±Inf values are kept as valid ones.
+// Data which already has the validity bitmap is returned as is.
+func (d Data[T]) MaskNaN() Data[T] {
+	if d.HasValidity() {
+		return d
+	}
+	d.valid = bitmapOf(d.values)
+	return d
+}
+
+func (d Data[T]) isNA(i int) bool {
+	if d.valid.bits != nil {
+		return !d.valid.Get(i)
+	}
+	return IsNA(d.values[i])
+}
+
+// validValues appends values which are not missing to dst.
+func (d Data[T]) validValues(dst []T) []T {
+	for i, v := range d.values {
+		if !d.isNA(i) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+func (d Data[T]) setValid(i int, valid bool) {
+	if d.valid.bits != nil {
+		d.valid.Set(i, valid)
+	}
+}
+
+// maskedLike makes the validity bitmap from NaN values of the result
+// if the source data has the validity bitmap.
+// Result values are computed by aggregations which return NaN for missing values.
+func (d Data[T]) maskedLike(src Data[T]) Data[T] {
+	if src.HasValidity() {
+		d.valid = bitmapOf(d.values)
+	} else {
+		d.valid = Bitmap{}
+	}
+	return d
 }
 
 // Index returns underlying index values.
-func (d Data) Index() (index []int64) {
+func (d Data[T]) Index() (index []int64) {
 	return d.index
 }
 
 // Values returns data  data values.
-func (d Data) Values() (values []DType) {
+func (d Data[T]) Values() (values []T) {
 	return d.values
 }
 
 // Len returns size of series values.
-func (d Data) Len() int {
+func (d Data[T]) Len() int {
 	return len(d.values)
 }
 
@@ -121,7 +242,7 @@ func (d Data) Len() int {
 // x will be converted to seconds.
 //
 // i can be negative.
-func (d Data) XY(i int) (x, y float64) {
+func (d Data[T]) XY(i int) (x, y float64) {
 	if i < 0 {
 		i = len(d.values) + i
 	}
@@ -129,17 +250,17 @@ func (d Data) XY(i int) (x, y float64) {
 }
 
 // Freq returns period length of one sample.
-func (d Data) Freq() int64 {
+func (d Data[T]) Freq() int64 {
 	return d.freq
 }
 
 // Equals tests data searies are equal to each other.
 // NaN values are considered to be equal.
-func (d Data) Equals(r Data, eps DType) bool {
+func (d Data[T]) Equals(r Data[T], eps T) bool {
 	return d.IndexEquals(r) && d.ValuesEquals(r, eps)
 }
 
-func (d Data) IndexEquals(r Data) bool {
+func (d Data[T]) IndexEquals(r Data[T]) bool {
 	valuesLeft := d.index
 	valuesRight := r.index
 
@@ -156,7 +277,7 @@ func (d Data) IndexEquals(r Data) bool {
 	return true
 }
 
-func (d Data) ValuesEquals(r Data, eps DType) bool {
+func (d Data[T]) ValuesEquals(r Data[T], eps T) bool {
 	valuesLeft := d.values
 	valuesRight := r.values
 
@@ -165,11 +286,15 @@ func (d Data) ValuesEquals(r Data, eps DType) bool {
 	}
 
 	for i := range valuesLeft {
+		if d.isNA(i) != r.isNA(i) {
+			return false
+		}
+
 		left := valuesLeft[i]
 		right := valuesRight[i]
 
-		nanL := IsNA(left)
-		nanR := IsNA(right)
+		nanL := d.isNA(i)
+		nanR := r.isNA(i)
 
 		nanEq := nanL && nanR
 
@@ -179,7 +304,7 @@ func (d Data) ValuesEquals(r Data, eps DType) bool {
 
 		if nanL || nanR {
 			return false
-		} else if !fpEq(left, right, eps) {
+		} else if left != right && !fpEq(left, right, eps) {
 			return false
 		}
 	}
@@ -188,10 +313,9 @@ func (d Data) ValuesEquals(r Data, eps DType) bool {
 }
 
 // HasNA returns true if values has at least one n/a.
-func (d Data) HasNA() bool {
-	values := d.Values()
-	for _, v := range values {
-		if IsNA(v) {
+func (d Data[T]) HasNA() bool {
+	for i := range d.values {
+		if d.isNA(i) {
 			return true
 		}
 	}
@@ -200,26 +324,28 @@ func (d Data) HasNA() bool {
 
 // Slice makes valuesice of values.
 // l and r can be negatvie values.
-func (d Data) Slice(l, r int) Data {
+func (d Data[T]) Slice(l, r int) Data[T] {
 	if l < 0 {
 		l = len(d.values) + l
 	}
 	if r < 0 {
 		r = (len(d.values) + r) + 1
 	}
-	return Data{
+	return Data[T]{
 		d.freq,
 		d.index[l:r],
 		d.values[l:r],
+		d.valid.Slice(l, r),
 	}
 }
 
 // Clone makes full copy of values.
-func (d Data) Clone() Data {
-	clone := Data{
+func (d Data[T]) Clone() Data[T] {
+	clone := Data[T]{
 		freq:   d.freq,
 		index:  append([]int64(nil), d.index...),
-		values: append([]DType(nil), d.values...),
+		values: append([]T(nil), d.values...),
+		valid:  d.valid.Clone(),
 	}
 	return clone
 }
@@ -227,8 +353,8 @@ func (d Data) Clone() Data {
 // Resize resizes underlying arrays.
 //
 // New index values are filled by MaxInt64.
-// New values values are filled by NaN.
-func (d Data) Resize(newLen int) Data {
+// New values values are filled by NaN and marked as missing.
+func (d Data[T]) Resize(newLen int) Data[T] {
 	if newLen < 0 {
 		panic("newLen must be positive value")
 	}
@@ -247,35 +373,61 @@ func (d Data) Resize(newLen int) Data {
 		}
 
 		for i := 0; i < dt; i++ {
-			d.values = append(d.values, math.NaN())
+			d.values = append(d.values, math.NaN[T]())
 		}
 	}
 
+	if d.HasValidity() {
+		d.valid = d.valid.Resize(newLen, false)
+	}
+
 	return d
 }
 
 // Append appends new values to series values.
-func (d Data) Append(r Data) Data {
+// The validity bitmap is made for the result if any of data has it.
+func (d Data[T]) Append(r Data[T]) Data[T] {
+	if d.HasValidity() || r.HasValidity() {
+		d = d.MaskNA()
+		for i := range r.values {
+			d.valid = d.valid.Append(!r.isNA(i))
+		}
+	}
 	d.index = append(d.index, r.index...)
 	d.values = append(d.values, r.values...)
 	return d
 }
 
 // AppendXY appends x to indices, y to values.
-func (d Data) AppendXY(x int64, y DType) Data {
+func (d Data[T]) AppendXY(x int64, y T) Data[T] {
 	d.index = append(d.index, x)
 	d.values = append(d.values, y)
+	if d.HasValidity() {
+		d.valid = d.valid.Append(true)
+	}
 	return d
 }
 
-func (d Data) Add(r Data) Data {
+// andValidity combines validity bitmaps of element-wise operation operands.
+func (d Data[T]) andValidity(r Data[T]) Data[T] {
+	switch {
+	case !r.HasValidity():
+		return d
+	case !d.HasValidity():
+		d = d.MaskNA()
+	}
+	d.valid.And(r.valid)
+	return d
+}
+
+func (d Data[T]) Add(r Data[T]) Data[T] {
 	// Slices prevent implicit bounds checks.
 	values := d.values
 	sr := r.values
 
 	if EnabledAVX2 {
 		vek.Add(values, sr)
-		return d
+		return d.andValidity(r)
 	}
 
 	if len(values) != len(sr) {
@@ -286,17 +438,17 @@ func (d Data) Add(r Data) Data {
 		values[i] += sr[i]
 	}
 
-	return d
+	return d.andValidity(r)
 }
 
-func (d Data) Sub(r Data) Data {
+func (d Data[T]) Sub(r Data[T]) Data[T] {
 	// Slices prevent implicit bounds checks.
 	values := d.values
 	sr := r.values
 
 	if EnabledAVX2 {
 		vek.Sub(values, sr)
-		return d
+		return d.andValidity(r)
 	}
 
 	if len(values) != len(sr) {
@@ -307,17 +459,17 @@ func (d Data) Sub(r Data) Data {
 		values[i] -= sr[i]
 	}
 
-	return d
+	return d.andValidity(r)
 }
 
-func (d Data) Mul(r Data) Data {
+func (d Data[T]) Mul(r Data[T]) Data[T] {
 	// Slices prevent implicit bounds checks.
 	values := d.values
 	sr := r.values
 
 	if EnabledAVX2 {
 		vek.Mul(values, sr)
-		return d
+		return d.andValidity(r)
 	}
 
 	if len(values) != len(sr) {
@@ -328,17 +480,17 @@ func (d Data) Mul(r Data) Data {
 		values[i] *= sr[i]
 	}
 
-	return d
+	return d.andValidity(r)
 }
 
-func (d Data) Div(r Data) Data {
+func (d Data[T]) Div(r Data[T]) Data[T] {
 	// Slices prevent implicit bounds checks.
 	values := d.values
 	sr := r.values
 
 	if EnabledAVX2 {
 		vek.Div(values, sr)
-		return d
+		return d.andValidity(r)
 	}
 
 	if len(values) != len(sr) {
@@ -349,10 +501,10 @@ func (d Data) Div(r Data) Data {
 		values[i] /= sr[i]
 	}
 
-	return d
+	return d.andValidity(r)
 }
 
-func (d Data) Mod(r Data) Data {
+func (d Data[T]) Mod(r Data[T]) Data[T] {
 	// Slices prevent implicit bounds checks.
 	values := d.values
 	sr := r.values
@@ -365,17 +517,17 @@ func (d Data) Mod(r Data) Data {
 		values[i] = math.Mod(v, sr[i])
 	}
 
-	return d
+	return d.andValidity(r)
 }
 
-func (d Data) Max(r Data) Data {
+func (d Data[T]) Max(r Data[T]) Data[T] {
 	// Slices prevent implicit bounds checks.
 	values := d.values
 	sr := r.values
 
 	if EnabledAVX2 {
 		vek.Maximum(values, sr)
-		return d
+		return d.andValidity(r)
 	}
 
 	if len(values) != len(sr) {
@@ -386,17 +538,17 @@ func (d Data) Max(r Data) Data {
 		values[i] = math.Max(v, sr[i])
 	}
 
-	return d
+	return d.andValidity(r)
 }
 
-func (d Data) Min(r Data) Data {
+func (d Data[T]) Min(r Data[T]) Data[T] {
 	// Slices prevent implicit bounds checks.
 	values := d.values
 	sr := r.values
 
 	if EnabledAVX2 {
 		vek.Minimum(values, sr)
-		return d
+		return d.andValidity(r)
 	}
 
 	if len(values) != len(sr) {
@@ -407,11 +559,11 @@ func (d Data) Min(r Data) Data {
 		values[i] = math.Min(v, sr[i])
 	}
 
-	return d
+	return d.andValidity(r)
 }
 
 // Dot returns scalar of vectors production.
-func (d Data) Dot(r Data) DType {
+func (d Data[T]) Dot(r Data[T]) T {
 	valuesL := d.values
 	valuesR := r.values
 
@@ -423,7 +575,7 @@ func (d Data) Dot(r Data) DType {
 		panic("sizes of values at series must be equal")
 	}
 
-	var dot DType
+	var dot T
 
 	for i := range valuesL {
 		vL, vR := valuesL[i], valuesR[i]
@@ -433,7 +585,7 @@ func (d Data) Dot(r Data) DType {
 	return dot
 }
 
-func (d Data) AddScalar(s DType) Data {
+func (d Data[T]) AddScalar(s T) Data[T] {
 	values := d.values
 
 	if EnabledAVX2 {
@@ -447,7 +599,7 @@ func (d Data) AddScalar(s DType) Data {
 	return d
 }
 
-func (d Data) SubScalar(s DType) Data {
+func (d Data[T]) SubScalar(s T) Data[T] {
 	values := d.values
 
 	if EnabledAVX2 {
@@ -461,7 +613,7 @@ func (d Data) SubScalar(s DType) Data {
 	return d
 }
 
-func (d Data) MulScalar(s DType) Data {
+func (d Data[T]) MulScalar(s T) Data[T] {
 	values := d.values
 
 	if EnabledAVX2 {
@@ -475,7 +627,7 @@ func (d Data) MulScalar(s DType) Data {
 	return d
 }
 
-func (d Data) DivScalar(s DType) Data {
+func (d Data[T]) DivScalar(s T) Data[T] {
 	values := d.values
 
 	if EnabledAVX2 {
@@ -489,7 +641,7 @@ func (d Data) DivScalar(s DType) Data {
 	return d
 }
 
-func (d Data) Sign() Data {
+func (d Data[T]) Sign() Data[T] {
 	values := d.values
 	for i, v := range values {
 		values[i] = math.Copysign(1, v)
@@ -497,10 +649,10 @@ func (d Data) Sign() Data {
 	return d
 }
 
-func (d Data) Sin() Data {
+func (d Data[T]) Sin() Data[T] {
 	values := d.values
 
-	if EnabledAVX2 && EnabledFloat32 {
+	if EnabledAVX2 && math.Is32[T]() {
 		vek.Sin(values)
 		return d
 	}
@@ -511,7 +663,7 @@ func (d Data) Sin() Data {
 	return d
 }
 
-func (d Data) Asin() Data {
+func (d Data[T]) Asin() Data[T] {
 	values := d.values
 	for i, v := range values {
 		values[i] = math.Asin(v)
@@ -519,10 +671,10 @@ func (d Data) Asin() Data {
 	return d
 }
 
-func (d Data) Cos() Data {
+func (d Data[T]) Cos() Data[T] {
 	values := d.values
 
-	if EnabledAVX2 && EnabledFloat32 {
+	if EnabledAVX2 && math.Is32[T]() {
 		vek.Cos(values)
 		return d
 	}
@@ -533,7 +685,7 @@ func (d Data) Cos() Data {
 	return d
 }
 
-func (d Data) Acos() Data {
+func (d Data[T]) Acos() Data[T] {
 	values := d.values
 	for i, v := range values {
 		values[i] = math.Acos(v)
@@ -541,7 +693,7 @@ func (d Data) Acos() Data {
 	return d
 }
 
-func (d Data) Tan() Data {
+func (d Data[T]) Tan() Data[T] {
 	values := d.values
 	for i, v := range values {
 		values[i] = math.Tan(v)
@@ -549,7 +701,7 @@ func (d Data) Tan() Data {
 	return d
 }
 
-func (d Data) Atan() Data {
+func (d Data[T]) Atan() Data[T] {
 	values := d.values
 	for i, v := range values {
 		values[i] = math.Atan(v)
@@ -558,7 +710,7 @@ func (d Data) Atan() Data {
 }
 
 // Pow applies x**y, the base-x exponential of y.
-func (d Data) Pow(exp DType) Data {
+func (d Data[T]) Pow(exp T) Data[T] {
 	values := d.values
 	for i, v := range values {
 		values[i] = math.Pow(v, exp)
@@ -567,16 +719,16 @@ func (d Data) Pow(exp DType) Data {
 }
 
 // Pow10 applies 10**e, the base-10 exponential of e.
-func (d Data) Pow10() Data {
+func (d Data[T]) Pow10() Data[T] {
 	values := d.values
 	for i, v := range values {
-		values[i] = math.Pow10(int(v))
+		values[i] = math.Pow10[T](int(v))
 	}
 	return d
 }
 
 // Sqr applies x**2, the base-x exponential of 2.
-func (d Data) Sqr() Data {
+func (d Data[T]) Sqr() Data[T] {
 	values := d.values
 	for i, v := range values {
 		values[i] *= v
@@ -585,10 +737,10 @@ func (d Data) Sqr() Data {
 }
 
 // Exp applies e**x, the base-e exponential of x.
-func (d Data) Exp() Data {
+func (d Data[T]) Exp() Data[T] {
 	values := d.values
 
-	if EnabledAVX2 && EnabledFloat32 {
+	if EnabledAVX2 && math.Is32[T]() {
 		vek.Exp(values)
 		return d
 	}
@@ -600,7 +752,7 @@ func (d Data) Exp() Data {
 }
 
 // Exp2 applies 2**x, the base-2 exponential of x.
-func (d Data) Exp2() Data {
+func (d Data[T]) Exp2() Data[T] {
 	values := d.values
 	for i, v := range values {
 		values[i] = math.Exp2(v)
@@ -609,10 +761,10 @@ func (d Data) Exp2() Data {
 }
 
 // Log applies natural logarithm function to values of values.
-func (d Data) Log() Data {
+func (d Data[T]) Log() Data[T] {
 	values := d.values
 
-	if EnabledAVX2 && EnabledFloat32 {
+	if EnabledAVX2 && math.Is32[T]() {
 		vek.Log(values)
 		return d
 	}
@@ -624,10 +776,10 @@ func (d Data) Log() Data {
 }
 
 // Log2 applies Log2(x).
-func (d Data) Log2() Data {
+func (d Data[T]) Log2() Data[T] {
 	values := d.values
 
-	if EnabledAVX2 && EnabledFloat32 {
+	if EnabledAVX2 && math.Is32[T]() {
 		vek.Log2(values)
 		return d
 	}
@@ -639,10 +791,10 @@ func (d Data) Log2() Data {
 }
 
 // Log10 applies Log10(x).
-func (d Data) Log10() Data {
+func (d Data[T]) Log10() Data[T] {
 	values := d.values
 
-	if EnabledAVX2 && EnabledFloat32 {
+	if EnabledAVX2 && math.Is32[T]() {
 		vek.Log10(values)
 		return d
 	}
@@ -654,7 +806,7 @@ func (d Data) Log10() Data {
 }
 
 // Abs replace each elemnt by their absolute value.
-func (d Data) Abs() Data {
+func (d Data[T]) Abs() Data[T] {
 	values := d.values
 
 	if EnabledAVX2 {
@@ -669,7 +821,7 @@ func (d Data) Abs() Data {
 }
 
 // Floor returns the greatest integer value less than or equal to x.
-func (d Data) Floor() Data {
+func (d Data[T]) Floor() Data[T] {
 	values := d.values
 
 	if EnabledAVX2 {
@@ -684,7 +836,7 @@ func (d Data) Floor() Data {
 }
 
 // Trunc returns the integer value of x.
-func (d Data) Trunc() Data {
+func (d Data[T]) Trunc() Data[T] {
 	values := d.values
 	for i, v := range values {
 		values[i] = math.Trunc(v)
@@ -693,7 +845,7 @@ func (d Data) Trunc() Data {
 }
 
 // Round returns the nearest integer, rounding half away from zero.
-func (d Data) Round() Data {
+func (d Data[T]) Round() Data[T] {
 	values := d.values
 
 	if EnabledAVX2 {
@@ -702,21 +854,21 @@ func (d Data) Round() Data {
 	}
 
 	for i, v := range values {
-		values[i] = DType(math.Round(v))
+		values[i] = T(math.Round(v))
 	}
 	return d
 }
 
 // RoundToEven returns the nearest integer, rounding ties to even.
-func (d Data) RoundToEven() Data {
+func (d Data[T]) RoundToEven() Data[T] {
 	values := d.values
 	for i, v := range values {
-		values[i] = DType(math.RoundToEven(v))
+		values[i] = T(math.RoundToEven(v))
 	}
 	return d
 }
 
-func (d Data) Ceil() Data {
+func (d Data[T]) Ceil() Data[T] {
 	values := d.values
 
 	if EnabledAVX2 {
@@ -732,13 +884,13 @@ func (d Data) Ceil() Data {
 
 // Cumsum returns cumulative sum over values.
 // NaN values are ignored.
-func (d Data) Cumsum() Data {
-	var sum DType
+func (d Data[T]) Cumsum() Data[T] {
+	var sum T
 
 	values := d.values
 
 	for i, v := range values {
-		if IsNA(v) {
+		if d.isNA(i) {
 			continue
 		}
 		sum += v
@@ -749,7 +901,7 @@ func (d Data) Cumsum() Data {
 }
 
 // Apply applies user's function to every value of values.
-func (d Data) Apply(fn func(DType) DType) Data {
+func (d Data[T]) Apply(fn func(T) T) Data[T] {
 	values := d.values
 	for i, v := range values {
 		values[i] = fn(v)
@@ -758,12 +910,12 @@ func (d Data) Apply(fn func(DType) DType) Data {
 }
 
 // Reverse reverses index and values values.
-func (d Data) Reverse() Data {
+func (d Data[T]) Reverse() Data[T] {
 	return d.IndexReverse().DataReverse()
 }
 
 // Reverse reverses only index values.
-func (d Data) IndexReverse() Data {
+func (d Data[T]) IndexReverse() Data[T] {
 	values := d.index
 
 	if l := len(values); l <= 1 {
@@ -791,9 +943,13 @@ func (d Data) IndexReverse() Data {
 }
 
 // Reverse reverses only values values.
-func (d Data) DataReverse() Data {
+func (d Data[T]) DataReverse() Data[T] {
 	values := d.values
 
+	if d.HasValidity() {
+		d.valid.Reverse()
+	}
+
 	if l := len(values); l <= 1 {
 		return d
 	} else if l == 2 {
@@ -819,11 +975,13 @@ func (d Data) DataReverse() Data {
 }
 
 // Fillna fills NaN values.
-func (d Data) Fillna(value DType) Data {
+// Filled values are marked as valid.
+func (d Data[T]) Fillna(value T) Data[T] {
 	values := d.Values()
-	for i, v := range values {
-		if IsNA(v) {
+	for i := range values {
+		if d.isNA(i) {
 			values[i] = value
+			d.setValid(i, true)
 		}
 	}
 	return d
@@ -833,54 +991,68 @@ func (d Data) Fillna(value DType) Data {
 //
 // If series starts with NaN, it will be
 // filled by the first non-NaN value.
-func (d Data) Pad() Data {
-	fill := func(dst []DType, v DType) {
-		for i := range dst {
-			dst[i] = v
-		}
-	}
-
+func (d Data[T]) Pad() Data[T] {
 	values := d.Values()
-	item := math.NaN()
+
+	var (
+		item  T
+		found bool
+	)
 
 	begin := -1
 	end := -1
 
 	for i, v := range values {
-		if IsNA(v) {
+		if d.isNA(i) {
 			if begin == end {
 				begin = i
 			}
 			continue
 		}
 
-		if begin >= 0 && begin < i && !IsNA(item) {
+		if begin >= 0 && begin < i && found {
 			end = i
-			fill(values[begin:end], item)
+			d.fill(begin, end, item)
 			begin = end
 		}
 
 		item = v
+		found = true
 	}
 
-	if begin >= 0 && !IsNA(item) {
-		fill(values[begin:], item)
+	if begin >= 0 && found {
+		d.fill(begin, len(values), item)
 	}
 
 	return d
 }
 
+// fill fills values of [begin, end) range by v and marks them as valid.
+func (d Data[T]) fill(begin, end int, v T) {
+	values := d.values[begin:end]
+	for i := range values {
+		values[i] = v
+	}
+	if d.HasValidity() {
+		for i := begin; i < end; i++ {
+			d.valid.Set(i, true)
+		}
+	}
+}
+
 // Lerp fills NaNs between known values by linear interpolation method.
-func (d Data) Lerp() Data {
+func (d Data[T]) Lerp() Data[T] {
 	values := d.values
 
 	if len(values) == 0 {
 		return d
 	}
 
-	fill := func(y []DType, k, b DType) {
+	fill := func(beg, end int, k, b T) {
+		y := values[beg:end]
 		for x := range y {
-			y[x] = k*DType(x+1) + b
+			y[x] = k*T(x+1) + b
+			d.setValid(beg+x, true)
 		}
 	}
 
@@ -893,31 +1065,28 @@ func (d Data) Lerp() Data {
 			// Exit.
 			return d
 		}
-		if v := values[i]; !IsNA(v) {
+		if !d.isNA(i) {
 			beg = i
 			break
 		}
 	}
 
-	var left, right DType
+	var left, right T
 
 	left = values[beg]
 
 	for i := beg + 1; i < len(values); i++ {
-		val := values[i]
-
-		if IsNA(val) {
+		if d.isNA(i) {
 			continue
 		}
 
 		end = i
-		right = val
+		right = values[i]
 
 		if dst := end - beg; dst >= 2 {
-			line := values[beg+1 : end]
-			k := (right - left) / DType(dst)
+			k := (right - left) / T(dst)
 			b := left
-			fill(line, k, b)
+			fill(beg+1, end, k, b)
 		}
 
 		beg = end
@@ -933,10 +1102,14 @@ func (d Data) Lerp() Data {
 // Old and new have the same internal arrays. No additional memory is used.
 //
 // Safe for the empty index.
-func (d Data) Shrink() Data {
+func (d Data[T]) Shrink() Data[T] {
+	if d.HasValidity() {
+		return d.shrinkValid()
+	}
+
 	var (
 		index  []int64
-		values []DType
+		values []T
 	)
 
 	if l := d.Len(); l == 0 {
@@ -1019,8 +1192,35 @@ func (d Data) Shrink() Data {
 	return MakeData(d.freq, index, values)
 }
 
+// shrinkValid removes values marked as missing by the validity bitmap.
+func (d Data[T]) shrinkValid() Data[T] {
+	n := 0
+
+	for i := range d.values {
+		if d.isNA(i) {
+			continue
+		}
+		if n != i {
+			if d.index != nil {
+				d.index[n] = d.index[i]
+			}
+			d.values[n] = d.values[i]
+		}
+		n++
+	}
+
+	if d.index != nil {
+		d.index = d.index[:n]
+	}
+	d.values = d.values[:n]
+	d.valid = d.valid.Slice(0, n)
+	d.valid.Fill(true)
+
+	return d
+}
+
 // Diff calculates the difference of a series values elements.
-func (d Data) Diff(periods int) Data {
+func (d Data[T]) Diff(periods int) Data[T] {
 	values := d.Values()
 
 	if periods < 0 {
@@ -1029,7 +1229,13 @@ func (d Data) Diff(periods int) Data {
 		return d
 	}
 
-	var naVals []DType
+	var naVals []T
+
+	if d.HasValidity() {
+		for i := len(values) - 1; i >= 0; i-- {
+			d.valid.Set(i, i >= periods && d.valid.Get(i) && d.valid.Get(i-periods))
+		}
+	}
 
 	if len(values) > periods {
 		lv := values[:len(values)-periods]
@@ -1045,27 +1251,31 @@ func (d Data) Diff(periods int) Data {
 	}
 
 	for i := range naVals {
-		naVals[i] = math.NaN()
+		naVals[i] = math.NaN[T]()
 	}
 
 	return d
 }
 
 // Shift shifts values by specified periods count.
-func (d Data) Shift(periods int) Data {
+func (d Data[T]) Shift(periods int) Data[T] {
 	if periods == 0 {
 		return d
 	}
 
 	values := d.Values()
 
+	if d.HasValidity() {
+		d.valid.Shift(periods)
+	}
+
 	var (
-		naVals []DType
-		dst    []DType
-		src    []DType
+		naVals []T
+		dst    []T
+		src    []T
 	)
 
-	if shlen := int(math.Abs(DType(periods))); shlen < len(values) {
+	if shlen := int(math.Abs(T(periods))); shlen < len(values) {
 		if periods > 0 {
 			naVals = values[:shlen]
 			dst = values[shlen:]
@@ -1082,34 +1292,54 @@ func (d Data) Shift(periods int) Data {
 	}
 
 	for i := range naVals {
-		naVals[i] = math.NaN()
+		naVals[i] = math.NaN[T]()
 	}
 
 	return d
 }
 
-// Rolling provides rolling window calculations.
-func (d Data) Rolling(window int) Window {
-	return Window{
+// Rolling provides rolling window calculations over window count of values.
+func (d Data[T]) Rolling(window int, opts ...WindowOption) Window[T] {
+	return Window[T]{
 		len:  window,
 		data: d,
+		opts: makeWindowOptions(opts),
 	}
 }
 
 // EWM provides exponential weighted calculations.
-func (d Data) EWM(atype AlphaType, param DType, adjust bool, ignoreNA bool) ExpWindow {
-	return ExpWindow{
+func (d Data[T]) EWM(atype AlphaType, param T, adjust bool, ignoreNA bool, opts ...ExpWindowOption) ExpWindow[T] {
+	return ExpWindow[T]{
 		data:     d,
 		atype:    atype,
 		param:    param,
 		adjust:   adjust,
 		ignoreNA: ignoreNA,
+		opts:     makeExpWindowOptions(opts),
+	}
+}
+
+// EWMDuration provides exponential weighted calculations with weights decaying by half per halflife,
+// the decay of every step depends on the gap between consecutive index values.
+// Only adjusted weights are supported like pandas ewm(times=...).
+func (d Data[T]) EWMDuration(halflife time.Duration, ignoreNA bool, opts ...ExpWindowOption) ExpWindow[T] {
+	if halflife <= 0 {
+		panic("halflife duration must be greater than zero")
+	}
+	return ExpWindow[T]{
+		data:     d,
+		atype:    AlphaHalflife,
+		param:    1,
+		adjust:   true,
+		ignoreNA: ignoreNA,
+		halflife: halflife,
+		opts:     makeExpWindowOptions(opts),
 	}
 }
 
 // RollData applies custom function to rolling window of values.
 // Function accepts window bounds.
-func (d Data) RollData(window int, cb func(l int, r int)) {
+func (d Data[T]) RollData(window int, cb func(l int, r int)) {
 	if len(d.values) <= window {
 		cb(0, len(d.values))
 	}
@@ -1118,18 +1348,18 @@ func (d Data) RollData(window int, cb func(l int, r int)) {
 	}
 }
 
-func (d Data) Resample(freq int64, origin ResampleOrigin) Resampler {
-	if freq <= 0 {
-		panic("resampling frequency must be greater than zero")
+// Resample provides resampling of values by freq periods.
+func (d Data[T]) Resample(freq int64, origin ResampleOrigin, opts ...ResampleOption) Resampler[T] {
+	return Resampler[T]{
+		resampleRule: makeResampleRule(freq, origin, opts...),
+		data:         d,
 	}
-	switch origin {
-	case OriginEpoch, OriginStart, OriginStartDay:
-	default:
-		panic("unknown resampling origin type")
-	}
-	return Resampler{
-		data:   d,
-		freq:   freq,
-		origin: origin,
+}
+
+// ResampleCalendar provides resampling of values by variable length calendar periods.
+func (d Data[T]) ResampleCalendar(freq CalendarFreq, origin ResampleOrigin, opts ...ResampleOption) Resampler[T] {
+	return Resampler[T]{
+		resampleRule: makeCalendarRule(freq, origin, opts...),
+		data:         d,
 	}
 }
@@ -0,0 +1,175 @@
+package series
+
+import "strconv"
+
+// BoolData is the series of boolean values.
+// Missing values are marked by the optional validity bitmap.
+type BoolData struct {
+	column[bool]
+}
+
+// MakeBoolData makes series of boolean values.
+// freq is the size of values sample.
+func MakeBoolData(freq int64, index []int64, values []bool) BoolData {
+	return BoolData{makeColumn(freq, index, values, Bitmap{})}
+}
+
+// MakeBoolDataValid makes series of boolean values with the validity bitmap.
+// freq is the size of values sample.
+func MakeBoolDataValid(freq int64, index []int64, values []bool, valid Bitmap) BoolData {
+	return BoolData{makeColumn(freq, index, values, valid)}
+}
+
+// String converts series columns to string.
+// Index values are rendered as time.Duration.
+func (d BoolData) String() string {
+	return d.string(strconv.FormatBool)
+}
+
+// Equals tests series are equal to each other.
+// Missing values are considered to be equal.
+func (d BoolData) Equals(r BoolData) bool {
+	return columnEquals(d.column, r.column)
+}
+
+// Slice makes slice of values.
+// l and r can be negatvie values.
+func (d BoolData) Slice(l, r int) BoolData {
+	return BoolData{d.slice(l, r)}
+}
+
+// Clone makes full copy of values.
+func (d BoolData) Clone() BoolData {
+	return BoolData{d.clone()}
+}
+
+// IndexSort sorts data's index.
+func (d BoolData) IndexSort() BoolData {
+	d.indexSort(false)
+	return d
+}
+
+// IndexSortStable sorts data's index using stable sort algorithm.
+func (d BoolData) IndexSortStable() BoolData {
+	d.indexSort(true)
+	return d
+}
+
+// AsFloat32 converts values to float32 series data: true is 1, false is 0.
+func (d BoolData) AsFloat32() Data[float32] {
+	return columnAsData(d.column, boolValue[float32])
+}
+
+// AsFloat64 converts values to float64 series data: true is 1, false is 0.
+func (d BoolData) AsFloat64() Data[float64] {
+	return columnAsData(d.column, boolValue[float64])
+}
+
+func boolValue[T Float](v bool) T {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+// AsBool converts values to boolean series: non-zero values are true.
+// Missing values are marked by the validity bitmap.
+func (d Data[T]) AsBool() BoolData {
+	return BoolData{dataAsColumn(d, func(v T) (bool, bool) { return v != 0, true })}
+}
+
+// Resample provides resampling of boolean values.
+func (d BoolData) Resample(freq int64, origin ResampleOrigin, opts ...ResampleOption) BoolResampler {
+	return BoolResampler{
+		resampleRule: makeResampleRule(freq, origin, opts...),
+		data:         d,
+	}
+}
+
+// ResampleCalendar provides resampling by calendar periods of boolean values.
+func (d BoolData) ResampleCalendar(freq CalendarFreq, origin ResampleOrigin, opts ...ResampleOption) BoolResampler {
+	return BoolResampler{
+		resampleRule: makeCalendarRule(freq, origin, opts...),
+		data:         d,
+	}
+}
+
+// BoolResampler resamples boolean series.
+// Empty groups are marked as missing.
+type BoolResampler struct {
+	resampleRule
+	data BoolData
+}
+
+// Any reports whether sample group has at least one true value.
+func (res BoolResampler) Any() BoolData {
+	return res.Apply(func(group BoolData) (any bool, ok bool) {
+		for i, v := range group.values {
+			if group.isNA(i) {
+				continue
+			}
+			any = any || v
+			ok = true
+		}
+		return any, ok
+	})
+}
+
+// All reports whether all values of sample group are true.
+func (res BoolResampler) All() BoolData {
+	return res.Apply(func(group BoolData) (all bool, ok bool) {
+		all = true
+		for i, v := range group.values {
+			if group.isNA(i) {
+				continue
+			}
+			all = all && v
+			ok = true
+		}
+		return all, ok
+	})
+}
+
+// First applies first function to sample group.
+func (res BoolResampler) First() BoolData {
+	return res.Apply(func(group BoolData) (bool, bool) { return firstValid(group.column) })
+}
+
+// Last applies last function to sample group.
+func (res BoolResampler) Last() BoolData {
+	return res.Apply(func(group BoolData) (bool, bool) { return lastValid(group.column) })
+}
+
+// Count counts not missing values of sample group.
+func (res BoolResampler) Count() Int64Data {
+	return Int64Data{resampleColumn(res.data.column, res.resampleRule, func(group column[bool]) (int64, bool) {
+		return int64(group.countValid()), true
+	})}
+}
+
+// Sum counts true values of sample group.
+func (res BoolResampler) Sum() Int64Data {
+	return Int64Data{resampleColumn(res.data.column, res.resampleRule, func(group column[bool]) (sum int64, ok bool) {
+		for i, v := range group.values {
+			if group.isNA(i) {
+				continue
+			}
+			if v {
+				sum++
+			}
+			ok = true
+		}
+		return sum, ok
+	})}
+}
+
+// Apply applies custom function to sample group.
+// Function returns false as the second value if result value is missing.
+func (res BoolResampler) Apply(agg func(group BoolData) (bool, bool)) BoolData {
+	if agg == nil {
+		panic("aggregation func must not be nil!")
+	}
+	return BoolData{resampleColumn(res.data.column, res.resampleRule, func(group column[bool]) (bool, bool) {
+		return agg(BoolData{group})
+	})}
+}
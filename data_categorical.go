@@ -0,0 +1,309 @@
+package series
+
+import (
+	"strconv"
+
+	"github.com/WinPooh32/series/math"
+)
+
+// CategoricalData is the series of dictionary encoded string values.
+// Every value is stored as the code of the categories slice,
+// missing values are marked by the negative code.
+type CategoricalData struct {
+	codes      column[int32]
+	categories []string
+}
+
+// MakeCategoricalData makes series of categorical values.
+// Categories are listed in order of their first appearance.
+// freq is the size of values sample.
+func MakeCategoricalData(freq int64, index []int64, values []string) CategoricalData {
+	var (
+		codes      = make([]int32, len(values))
+		categories = []string{}
+		lookup     = map[string]int32{}
+	)
+
+	for i, v := range values {
+		code, ok := lookup[v]
+		if !ok {
+			code = int32(len(categories))
+			lookup[v] = code
+			categories = append(categories, v)
+		}
+		codes[i] = code
+	}
+
+	return MakeCategoricalCodes(freq, index, codes, categories)
+}
+
+// MakeCategoricalCodes makes series of categorical values from the codes of categories.
+// Negative code means missing value.
+// freq is the size of values sample.
+func MakeCategoricalCodes(freq int64, index []int64, codes []int32, categories []string) CategoricalData {
+	for _, code := range codes {
+		if int(code) >= len(categories) {
+			panic("code must be less than count of categories")
+		}
+	}
+	return CategoricalData{
+		codes:      makeColumn(freq, index, codes, Bitmap{}),
+		categories: categories,
+	}
+}
+
+// String converts series columns to string.
+// Index values are rendered as time.Duration.
+func (d CategoricalData) String() string {
+	return d.withNA().string(func(code int32) string { return strconv.Quote(d.categories[code]) })
+}
+
+// Index returns underlying index values.
+func (d CategoricalData) Index() (index []int64) {
+	return d.codes.index
+}
+
+// Codes returns underlying codes of values.
+func (d CategoricalData) Codes() []int32 {
+	return d.codes.values
+}
+
+// Categories returns underlying categories.
+func (d CategoricalData) Categories() []string {
+	return d.categories
+}
+
+// Len returns size of series values.
+func (d CategoricalData) Len() int {
+	return len(d.codes.values)
+}
+
+// Freq returns period length of one sample.
+func (d CategoricalData) Freq() int64 {
+	return d.codes.freq
+}
+
+// At returns value at i offset and false if the value is missing.
+// i can be negative.
+func (d CategoricalData) At(i int) (string, bool) {
+	if i < 0 {
+		i = len(d.codes.values) + i
+	}
+	code := d.codes.values[i]
+	if code < 0 {
+		return "", false
+	}
+	return d.categories[code], true
+}
+
+// IsNAAt reports whether value at i position is missing.
+// i can be negative.
+func (d CategoricalData) IsNAAt(i int) bool {
+	_, ok := d.At(i)
+	return !ok
+}
+
+// Equals tests series are equal to each other.
+// Values are compared by their categories, not by codes.
+// Missing values are considered to be equal.
+func (d CategoricalData) Equals(r CategoricalData) bool {
+	if !d.codes.indexEquals(r.codes) || d.Len() != r.Len() {
+		return false
+	}
+	for i := range d.codes.values {
+		l, okL := d.At(i)
+		r, okR := r.At(i)
+		if okL != okR || l != r {
+			return false
+		}
+	}
+	return true
+}
+
+// Slice makes slice of values.
+// Categories are shared with the source series.
+// l and r can be negatvie values.
+func (d CategoricalData) Slice(l, r int) CategoricalData {
+	return CategoricalData{
+		codes:      d.codes.slice(l, r),
+		categories: d.categories,
+	}
+}
+
+// Clone makes full copy of values.
+func (d CategoricalData) Clone() CategoricalData {
+	return CategoricalData{
+		codes:      d.codes.clone(),
+		categories: append([]string(nil), d.categories...),
+	}
+}
+
+// IndexSort sorts data's index.
+func (d CategoricalData) IndexSort() CategoricalData {
+	d.codes.indexSort(false)
+	return d
+}
+
+// IndexSortStable sorts data's index using stable sort algorithm.
+func (d CategoricalData) IndexSortStable() CategoricalData {
+	d.codes.indexSort(true)
+	return d
+}
+
+// AsFloat32 converts codes to float32 series data.
+// Missing values are converted to NaN.
+func (d CategoricalData) AsFloat32() Data[float32] {
+	return categoricalAsData[float32](d)
+}
+
+// AsFloat64 converts codes to float64 series data.
+// Missing values are converted to NaN.
+func (d CategoricalData) AsFloat64() Data[float64] {
+	return categoricalAsData[float64](d)
+}
+
+func categoricalAsData[T Float](d CategoricalData) Data[T] {
+	values := make([]T, d.Len())
+	for i, code := range d.codes.values {
+		if code < 0 {
+			values[i] = math.NaN[T]()
+			continue
+		}
+		values[i] = T(code)
+	}
+	return MakeData(d.codes.freq, d.codes.index, values)
+}
+
+// AsCategorical converts values to categorical series.
+// Every distinct value becomes the category formatted by strconv.FormatFloat.
+func (d Data[T]) AsCategorical() CategoricalData {
+	var (
+		codes      = make([]int32, len(d.values))
+		categories = []string{}
+		lookup     = map[T]int32{}
+		nanCode    = int32(-1)
+		bitSize    = 64
+	)
+
+	if math.Is32[T]() {
+		bitSize = 32
+	}
+
+	for i, v := range d.values {
+		if d.isNA(i) {
+			codes[i] = -1
+			continue
+		}
+		if v != v {
+			// NaN is not equal to itself, so it can't be a map key.
+			if nanCode < 0 {
+				nanCode = int32(len(categories))
+				categories = append(categories, "NaN")
+			}
+			codes[i] = nanCode
+			continue
+		}
+		code, ok := lookup[v]
+		if !ok {
+			code = int32(len(categories))
+			lookup[v] = code
+			categories = append(categories, strconv.FormatFloat(float64(v), 'g', -1, bitSize))
+		}
+		codes[i] = code
+	}
+
+	return MakeCategoricalCodes(d.freq, d.index, codes, categories)
+}
+
+// withNA returns codes column where negative codes are marked by the validity bitmap.
+func (d CategoricalData) withNA() column[int32] {
+	c := d.codes
+	c.valid = MakeBitmap(len(c.values), true)
+	for i, code := range c.values {
+		if code < 0 {
+			c.valid.Set(i, false)
+		}
+	}
+	return c
+}
+
+// Resample provides resampling of categorical values.
+func (d CategoricalData) Resample(freq int64, origin ResampleOrigin, opts ...ResampleOption) CategoricalResampler {
+	return CategoricalResampler{
+		resampleRule: makeResampleRule(freq, origin, opts...),
+		data:         d,
+	}
+}
+
+// ResampleCalendar provides resampling by calendar periods of categorical values.
+func (d CategoricalData) ResampleCalendar(freq CalendarFreq, origin ResampleOrigin, opts ...ResampleOption) CategoricalResampler {
+	return CategoricalResampler{
+		resampleRule: makeCalendarRule(freq, origin, opts...),
+		data:         d,
+	}
+}
+
+// CategoricalResampler resamples categorical series.
+// Empty groups are marked as missing.
+type CategoricalResampler struct {
+	resampleRule
+	data CategoricalData
+}
+
+// First applies first function to sample group.
+func (res CategoricalResampler) First() CategoricalData {
+	return res.apply(firstValid[int32])
+}
+
+// Last applies last function to sample group.
+func (res CategoricalResampler) Last() CategoricalData {
+	return res.apply(lastValid[int32])
+}
+
+// Mode returns the most frequent value of sample group.
+// Ties are resolved in favor of the category listed first.
+func (res CategoricalResampler) Mode() CategoricalData {
+	counts := make([]int, len(res.data.categories))
+
+	return res.apply(func(group column[int32]) (mode int32, ok bool) {
+		for i := range counts {
+			counts[i] = 0
+		}
+		for i, code := range group.values {
+			if group.isNA(i) {
+				continue
+			}
+			counts[code]++
+			ok = true
+		}
+		for code, n := range counts {
+			if n > counts[mode] {
+				mode = int32(code)
+			}
+		}
+		return mode, ok
+	})
+}
+
+// Count counts not missing values of sample group.
+func (res CategoricalResampler) Count() Int64Data {
+	return Int64Data{resampleColumn(res.data.withNA(), res.resampleRule, func(group column[int32]) (int64, bool) {
+		return int64(group.countValid()), true
+	})}
+}
+
+func (res CategoricalResampler) apply(agg func(group column[int32]) (int32, bool)) CategoricalData {
+	c := resampleColumn(res.data.withNA(), res.resampleRule, agg)
+
+	for i := range c.values {
+		if c.isNA(i) {
+			c.values[i] = -1
+		}
+	}
+	c.valid = Bitmap{}
+
+	return CategoricalData{
+		codes:      c,
+		categories: res.data.categories,
+	}
+}
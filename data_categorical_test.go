@@ -0,0 +1,76 @@
+package series
+
+import (
+	"testing"
+)
+
+func TestCategoricalData_Resample(t *testing.T) {
+	data := MakeCategoricalCodes(
+		1,
+		[]int64{1, 2, 3, 4, 5, 6, 7, 14},
+		[]int32{0, 1, 1, -1, 2, 0, -1, 2},
+		[]string{"idle", "run", "stop"},
+	)
+
+	tests := []struct {
+		name string
+		fn   func(CategoricalResampler) CategoricalData
+		want []string
+	}{
+		{"first", CategoricalResampler.First, []string{"idle", "stop", "NA", "stop"}},
+		{"last", CategoricalResampler.Last, []string{"run", "idle", "NA", "stop"}},
+		{"mode", CategoricalResampler.Mode, []string{"run", "idle", "NA", "stop"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fn(data.Resample(4, OriginStart))
+			if got.Len() != len(tt.want) {
+				t.Fatalf("CategoricalResampler = %v, want %v", got, tt.want)
+			}
+			for i, w := range tt.want {
+				v, ok := got.At(i)
+				if !ok {
+					v = "NA"
+				}
+				if v != w {
+					t.Errorf("CategoricalData.At(%d) = %v, want %v", i, v, w)
+				}
+			}
+		})
+	}
+
+	count := data.Resample(4, OriginStart).Count()
+	want := MakeInt64Data(4, []int64{1, 5, 9, 13}, []int64{3, 2, 0, 1})
+
+	if !count.Equals(want) {
+		t.Errorf("CategoricalResampler.Count() = %v, want %v", count, want)
+	}
+}
+
+func TestCategoricalData_Convert(t *testing.T) {
+	data := MakeCategoricalData(1, []int64{1, 2, 3, 4}, []string{"b", "a", "b", "c"})
+
+	if got := data.Categories(); len(got) != 3 || got[0] != "b" || got[1] != "a" || got[2] != "c" {
+		t.Fatalf("CategoricalData.Categories() = %v", got)
+	}
+
+	codes := data.AsFloat64()
+	if want := MakeData(1, []int64{1, 2, 3, 4}, []float64{0, 1, 0, 2}); !codes.Equals(want, EpsFp64) {
+		t.Errorf("CategoricalData.AsFloat64() = %v, want %v", codes, want)
+	}
+
+	got := MakeData(1, []int64{1, 2, 3, 4}, []float64{0.5, NaN, 0.5, 2}).AsCategorical()
+	want := MakeCategoricalCodes(1, []int64{1, 2, 3, 4}, []int32{0, -1, 0, 1}, []string{"0.5", "2"})
+
+	if !got.Equals(want) {
+		t.Errorf("Data.AsCategorical() = %v, want %v", got, want)
+	}
+
+	// Valid NaN values share the single category.
+	got = MakeDataValid(1, []int64{1, 2, 3}, []float64{NaN, 1, NaN}, makeBitmap(true, true, true)).AsCategorical()
+	want = MakeCategoricalCodes(1, []int64{1, 2, 3}, []int32{0, 1, 0}, []string{"NaN", "1"})
+
+	if !got.Equals(want) {
+		t.Errorf("Data.AsCategorical() = %v, want %v", got, want)
+	}
+}
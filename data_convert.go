@@ -1,11 +1,68 @@
-//go:build !series_f32
-
 package series
 
-import "github.com/WinPooh32/series/vek"
+import (
+	"github.com/WinPooh32/series/math"
+	"github.com/WinPooh32/series/vek"
+)
+
+// Convert converts series data to another precision of values.
+// Index is shared between the source and the result.
+// Values and validity bitmap are shared too if both precisions are equal,
+// otherwise they are copied.
+func Convert[U Float, T Float](d Data[T]) Data[U] {
+	var (
+		values []U
+		valid  Bitmap
+	)
+
+	if src, ok := any(d.values).([]U); ok {
+		values = src
+		valid = d.valid
+	} else {
+		values = make([]U, len(d.values))
+		convertValues(values, d.values)
+		valid = d.valid.Clone()
+	}
+
+	return Data[U]{
+		freq:   d.freq,
+		index:  d.index,
+		values: values,
+		valid:  valid,
+	}
+}
+
+// AsFloat32 converts series data to float32 precision.
+// See Convert for memory sharing rules.
+func (d Data[T]) AsFloat32() Data[float32] {
+	return Convert[float32](d)
+}
+
+// AsFloat64 converts series data to float64 precision.
+// See Convert for memory sharing rules.
+func (d Data[T]) AsFloat64() Data[float64] {
+	return Convert[float64](d)
+}
+
+func convertValues[U Float, T Float](dst []U, src []T) {
+	if EnabledAVX2 {
+		switch dst := any(dst).(type) {
+		case []float32:
+			vek.ToFloat32(dst, src)
+			return
+		case []float64:
+			vek.ToFloat64(dst, src)
+			return
+		}
+	}
+
+	for i, v := range src {
+		dst[i] = U(v)
+	}
+}
 
 // IndexAsInt32 returns copy of underlying index slice converted to int32 array.
-func (d Data) IndexAsInt32() (index []int32) {
+func (d Data[T]) IndexAsInt32() (index []int32) {
 	index = make([]int32, len(d.index))
 	for i, v := range d.index {
 		index[i] = int32(v)
@@ -14,7 +71,7 @@ func (d Data) IndexAsInt32() (index []int32) {
 }
 
 // IndexAsFloat32 returns copy of underlying index slice converted to float32 array.
-func (d Data) IndexAsFloat32() (index []float32) {
+func (d Data[T]) IndexAsFloat32() (index []float32) {
 	index = make([]float32, len(d.index))
 	for i, v := range d.index {
 		index[i] = float32(v)
@@ -23,7 +80,7 @@ func (d Data) IndexAsFloat32() (index []float32) {
 }
 
 // IndexAsFloat64 returns copy of underlying index slice converted to float64 array.
-func (d Data) IndexAsFloat64() (index []float64) {
+func (d Data[T]) IndexAsFloat64() (index []float64) {
 	index = make([]float64, len(d.index))
 	for i, v := range d.index {
 		index[i] = float64(v)
@@ -32,7 +89,7 @@ func (d Data) IndexAsFloat64() (index []float64) {
 }
 
 // ValuesAsInt32 returns copy of underlying values slice converted to int32 array.
-func (d Data) ValuesAsInt32() (values []int32) {
+func (d Data[T]) ValuesAsInt32() (values []int32) {
 	values = make([]int32, len(d.values))
 
 	switch {
@@ -41,7 +98,6 @@ func (d Data) ValuesAsInt32() (values []int32) {
 		return values
 
 	default:
-		values = make([]int32, len(d.values))
 		for i, v := range d.values {
 			values[i] = int32(v)
 		}
@@ -49,8 +105,8 @@ func (d Data) ValuesAsInt32() (values []int32) {
 	}
 }
 
-// ValuesAsInt64 returns copy of underlying values slice converted to float32 array.
-func (d Data) ValuesAsInt64() (values []int64) {
+// ValuesAsInt64 returns copy of underlying values slice converted to int64 array.
+func (d Data[T]) ValuesAsInt64() (values []int64) {
 	values = make([]int64, len(d.values))
 
 	switch {
@@ -66,24 +122,24 @@ func (d Data) ValuesAsInt64() (values []int64) {
 	}
 }
 
-// ValuesAsFloat32 returns copy of underlying values slice converted to float32 array.
-func (d Data) ValuesAsFloat32() (values []float32) {
-	values = make([]float32, len(d.values))
-
-	switch {
-	case EnabledAVX2:
-		vek.ToFloat32(values, d.values)
-		return values
-
-	default:
-		for i, v := range d.values {
-			values[i] = float32(v)
-		}
-		return values
+// ValuesAsFloat32 returns underlying values slice converted to float32 array.
+// The underlying slice is returned as is if T is float32, otherwise it is copied.
+func (d Data[T]) ValuesAsFloat32() (values []float32) {
+	if math.Is32[T]() {
+		return any(d.values).([]float32)
 	}
+	values = make([]float32, len(d.values))
+	convertValues(values, d.values)
+	return values
 }
 
-// DataAsFloat64 returns copy of underlying values slice converted to float64 array.
-func (d Data) ValuesAsFloat64() (values []float64) {
-	return d.values
+// ValuesAsFloat64 returns underlying values slice converted to float64 array.
+// The underlying slice is returned as is if T is float64, otherwise it is copied.
+func (d Data[T]) ValuesAsFloat64() (values []float64) {
+	if !math.Is32[T]() {
+		return any(d.values).([]float64)
+	}
+	values = make([]float64, len(d.values))
+	convertValues(values, d.values)
+	return values
 }
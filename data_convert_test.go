@@ -0,0 +1,60 @@
+package series
+
+import (
+	"testing"
+
+	"github.com/WinPooh32/series/math"
+)
+
+func TestConvert(t *testing.T) {
+	nan32 := math.NaN[float32]()
+
+	d64 := MakeData(1, []int64{1, 2, 3}, []float64{1.5, NaN, 3})
+	d32 := MakeData(1, []int64{1, 2, 3}, []float32{1.5, nan32, 3})
+
+	if got := Convert[float32](d64); !got.Equals(d32, EpsFp32) {
+		t.Errorf("Convert[float32]() = %v, want %v", got, d32)
+	}
+	if got := d32.AsFloat64(); !got.Equals(d64, EpsFp32) {
+		t.Errorf("Data.AsFloat64() = %v, want %v", got, d64)
+	}
+
+	same := d64.AsFloat64()
+	same.Set(0, 42)
+
+	if d64.At(0) != 42 {
+		t.Errorf("Data.AsFloat64() must share values of float64 data")
+	}
+	if &same.Index()[0] != &d64.Index()[0] {
+		t.Errorf("Data.AsFloat64() must share index")
+	}
+}
+
+func TestConvert_Validity(t *testing.T) {
+	d64 := MakeDataValid(1, []int64{1, 2, 3}, []float64{1, 42, 3}, makeBitmap(true, false, true))
+
+	d32 := d64.AsFloat32()
+	if !d32.IsNAAt(1) {
+		t.Errorf("Data.AsFloat32().IsNAAt(1) = false, want true")
+	}
+	if got := Mean(d32); got != 2 {
+		t.Errorf("Mean(Data.AsFloat32()) = %v, want %v", got, 2)
+	}
+
+	if got := d32.AsFloat64(); !got.IsNAAt(1) || Mean(got) != 2 {
+		t.Errorf("Data.AsFloat64() = %v, want masked value at 1", got)
+	}
+	if got := d64.AsFloat64(); !got.IsNAAt(1) {
+		t.Errorf("Data.AsFloat64().IsNAAt(1) = false, want true")
+	}
+}
+
+func TestWindow_Float32(t *testing.T) {
+	data := MakeData(1, []int64{1, 2, 3, 4, 5}, []float32{1, 2, 3, 4, 5})
+	nan32 := math.NaN[float32]()
+	want := MakeData(1, []int64{1, 2, 3, 4, 5}, []float32{nan32, nan32, 2, 3, 4})
+
+	if got := data.Rolling(3).Mean(); !got.Equals(want, EpsFp32) {
+		t.Errorf("Window.Mean() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,180 @@
+package series
+
+import "strconv"
+
+// Int64Data is the series of int64 values.
+// Missing values are marked by the optional validity bitmap.
+type Int64Data struct {
+	column[int64]
+}
+
+// MakeInt64Data makes series of int64 values.
+// freq is the size of values sample.
+func MakeInt64Data(freq int64, index []int64, values []int64) Int64Data {
+	return Int64Data{makeColumn(freq, index, values, Bitmap{})}
+}
+
+// MakeInt64DataValid makes series of int64 values with the validity bitmap.
+// freq is the size of values sample.
+func MakeInt64DataValid(freq int64, index []int64, values []int64, valid Bitmap) Int64Data {
+	return Int64Data{makeColumn(freq, index, values, valid)}
+}
+
+// String converts series columns to string.
+// Index values are rendered as time.Duration.
+func (d Int64Data) String() string {
+	return d.string(func(v int64) string { return strconv.FormatInt(v, 10) })
+}
+
+// Equals tests series are equal to each other.
+// Missing values are considered to be equal.
+func (d Int64Data) Equals(r Int64Data) bool {
+	return columnEquals(d.column, r.column)
+}
+
+// Slice makes slice of values.
+// l and r can be negatvie values.
+func (d Int64Data) Slice(l, r int) Int64Data {
+	return Int64Data{d.slice(l, r)}
+}
+
+// Clone makes full copy of values.
+func (d Int64Data) Clone() Int64Data {
+	return Int64Data{d.clone()}
+}
+
+// IndexSort sorts data's index.
+func (d Int64Data) IndexSort() Int64Data {
+	d.indexSort(false)
+	return d
+}
+
+// IndexSortStable sorts data's index using stable sort algorithm.
+func (d Int64Data) IndexSortStable() Int64Data {
+	d.indexSort(true)
+	return d
+}
+
+// AsFloat32 converts values to float32 series data.
+// Values beyond 2^24 lose exactness.
+func (d Int64Data) AsFloat32() Data[float32] {
+	return columnAsData(d.column, int64Value[float32])
+}
+
+// AsFloat64 converts values to float64 series data.
+// Values beyond 2^53 lose exactness.
+func (d Int64Data) AsFloat64() Data[float64] {
+	return columnAsData(d.column, int64Value[float64])
+}
+
+func int64Value[T Float](v int64) T {
+	return T(v)
+}
+
+// AsInt64 converts values to int64 series.
+// Values are truncated toward zero. Missing values and values out of int64 range
+// (NaN, ±Inf) are marked by the validity bitmap.
+func (d Data[T]) AsInt64() Int64Data {
+	return Int64Data{dataAsColumn(d, func(v T) (int64, bool) {
+		// Bounds are exact powers of two in both precisions.
+		if !(v >= -(1<<63) && v < 1<<63) {
+			return 0, false
+		}
+		return int64(v), true
+	})}
+}
+
+// Resample provides resampling of int64 values.
+func (d Int64Data) Resample(freq int64, origin ResampleOrigin, opts ...ResampleOption) Int64Resampler {
+	return Int64Resampler{
+		resampleRule: makeResampleRule(freq, origin, opts...),
+		data:         d,
+	}
+}
+
+// ResampleCalendar provides resampling by calendar periods of int64 values.
+func (d Int64Data) ResampleCalendar(freq CalendarFreq, origin ResampleOrigin, opts ...ResampleOption) Int64Resampler {
+	return Int64Resampler{
+		resampleRule: makeCalendarRule(freq, origin, opts...),
+		data:         d,
+	}
+}
+
+// Int64Resampler resamples int64 series.
+// Empty groups are marked as missing.
+type Int64Resampler struct {
+	resampleRule
+	data Int64Data
+}
+
+// Sum applies sum function to sample group.
+func (res Int64Resampler) Sum() Int64Data {
+	return res.Apply(func(group Int64Data) (sum int64, ok bool) {
+		for i, v := range group.values {
+			if group.isNA(i) {
+				continue
+			}
+			sum += v
+			ok = true
+		}
+		return sum, ok
+	})
+}
+
+// Min applies min function to sample group.
+func (res Int64Resampler) Min() Int64Data {
+	return res.Apply(func(group Int64Data) (min int64, ok bool) {
+		for i, v := range group.values {
+			if group.isNA(i) {
+				continue
+			}
+			if !ok || v < min {
+				min = v
+			}
+			ok = true
+		}
+		return min, ok
+	})
+}
+
+// Max applies max function to sample group.
+func (res Int64Resampler) Max() Int64Data {
+	return res.Apply(func(group Int64Data) (max int64, ok bool) {
+		for i, v := range group.values {
+			if group.isNA(i) {
+				continue
+			}
+			if !ok || v > max {
+				max = v
+			}
+			ok = true
+		}
+		return max, ok
+	})
+}
+
+// First applies first function to sample group.
+func (res Int64Resampler) First() Int64Data {
+	return res.Apply(func(group Int64Data) (int64, bool) { return firstValid(group.column) })
+}
+
+// Last applies last function to sample group.
+func (res Int64Resampler) Last() Int64Data {
+	return res.Apply(func(group Int64Data) (int64, bool) { return lastValid(group.column) })
+}
+
+// Count counts not missing values of sample group.
+func (res Int64Resampler) Count() Int64Data {
+	return res.Apply(func(group Int64Data) (int64, bool) { return int64(group.countValid()), true })
+}
+
+// Apply applies custom function to sample group.
+// Function returns false if result value is missing.
+func (res Int64Resampler) Apply(agg func(group Int64Data) (int64, bool)) Int64Data {
+	if agg == nil {
+		panic("aggregation func must not be nil!")
+	}
+	return Int64Data{resampleColumn(res.data.column, res.resampleRule, func(group column[int64]) (int64, bool) {
+		return agg(Int64Data{group})
+	})}
+}
@@ -0,0 +1,127 @@
+package series
+
+import (
+	"testing"
+
+	"github.com/WinPooh32/series/math"
+)
+
+func TestInt64Data_Resample(t *testing.T) {
+	const big = int64(1)<<53 + 1
+
+	data := MakeInt64DataValid(
+		1,
+		[]int64{1, 2, 3, 4, 5, 6, 9},
+		[]int64{big, 1, 7, 2, 5, 6, 3},
+		makeBitmap(true, true, false, true, true, true, true),
+	)
+
+	tests := []struct {
+		name string
+		fn   func(Int64Resampler) Int64Data
+		want Int64Data
+	}{
+		{
+			"sum",
+			Int64Resampler.Sum,
+			MakeInt64DataValid(2, []int64{1, 3, 5, 7, 9}, []int64{big + 1, 2, 11, 0, 3}, makeBitmap(true, true, true, false, true)),
+		},
+		{
+			"min",
+			Int64Resampler.Min,
+			MakeInt64DataValid(2, []int64{1, 3, 5, 7, 9}, []int64{1, 2, 5, 0, 3}, makeBitmap(true, true, true, false, true)),
+		},
+		{
+			"max",
+			Int64Resampler.Max,
+			MakeInt64DataValid(2, []int64{1, 3, 5, 7, 9}, []int64{big, 2, 6, 0, 3}, makeBitmap(true, true, true, false, true)),
+		},
+		{
+			"first",
+			Int64Resampler.First,
+			MakeInt64DataValid(2, []int64{1, 3, 5, 7, 9}, []int64{big, 2, 5, 0, 3}, makeBitmap(true, true, true, false, true)),
+		},
+		{
+			"last",
+			Int64Resampler.Last,
+			MakeInt64DataValid(2, []int64{1, 3, 5, 7, 9}, []int64{1, 2, 6, 0, 3}, makeBitmap(true, true, true, false, true)),
+		},
+		{
+			"count",
+			Int64Resampler.Count,
+			MakeInt64Data(2, []int64{1, 3, 5, 7, 9}, []int64{2, 1, 2, 0, 1}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fn(data.Resample(2, OriginStart)); !got.Equals(tt.want) {
+				t.Errorf("Int64Resampler = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInt64Data_Convert(t *testing.T) {
+	data := MakeData(1, []int64{1, 2, 3, 4}, []float64{1.5, NaN, -2.5, 4})
+
+	got := data.AsInt64()
+	want := MakeInt64DataValid(1, []int64{1, 2, 3, 4}, []int64{1, 0, -2, 4}, makeBitmap(true, false, true, true))
+
+	if !got.Equals(want) {
+		t.Fatalf("Data.AsInt64() = %v, want %v", got, want)
+	}
+
+	back := got.AsFloat64()
+	wantBack := MakeDataValid(1, []int64{1, 2, 3, 4}, []float64{1, NaN, -2, 4}, makeBitmap(true, false, true, true))
+
+	if !back.Equals(wantBack, EpsFp64) {
+		t.Errorf("Int64Data.AsFloat64() = %v, want %v", back, wantBack)
+	}
+
+	// Valid values out of int64 range are missing.
+	inf := math.Inf[float64](1)
+	wide := MakeDataValid(1, []int64{1, 2, 3, 4, 5}, []float64{NaN, inf, -inf, 1e19, -(1 << 63)}, makeBitmap(true, true, true, true, true))
+	wantWide := MakeInt64DataValid(1, []int64{1, 2, 3, 4, 5}, []int64{0, 0, 0, 0, -1 << 63}, makeBitmap(false, false, false, false, true))
+
+	if got := wide.AsInt64(); !got.Equals(wantWide) {
+		t.Errorf("Data.AsInt64() = %v, want %v", got, wantWide)
+	}
+}
+
+func TestInt64Data_IndexSort(t *testing.T) {
+	data := MakeInt64DataValid(1, []int64{3, 1, 2}, []int64{30, 10, 20}, makeBitmap(true, false, true))
+	want := MakeInt64DataValid(1, []int64{1, 2, 3}, []int64{10, 20, 30}, makeBitmap(false, true, true))
+
+	if got := data.Clone().IndexSort(); !got.Equals(want) {
+		t.Errorf("Int64Data.IndexSort() = %v, want %v", got, want)
+	}
+	if got := data.Slice(1, -1); got.Len() != 2 || !got.IsNAAt(0) || got.At(1) != 20 {
+		t.Errorf("Int64Data.Slice() = %v", got)
+	}
+}
+
+func TestBoolData_Resample(t *testing.T) {
+	data := MakeBoolDataValid(
+		1,
+		[]int64{1, 2, 3, 4, 5, 6},
+		[]bool{true, false, true, true, false, false},
+		makeBitmap(true, true, false, true, true, true),
+	)
+	res := data.Resample(2, OriginStart)
+
+	if got, want := res.Any(), MakeBoolData(2, []int64{1, 3, 5}, []bool{true, true, false}); !got.Equals(want) {
+		t.Errorf("BoolResampler.Any() = %v, want %v", got, want)
+	}
+	if got, want := res.All(), MakeBoolData(2, []int64{1, 3, 5}, []bool{false, true, false}); !got.Equals(want) {
+		t.Errorf("BoolResampler.All() = %v, want %v", got, want)
+	}
+	if got, want := res.Sum(), MakeInt64Data(2, []int64{1, 3, 5}, []int64{1, 1, 0}); !got.Equals(want) {
+		t.Errorf("BoolResampler.Sum() = %v, want %v", got, want)
+	}
+	if got, want := res.Count(), MakeInt64Data(2, []int64{1, 3, 5}, []int64{2, 1, 2}); !got.Equals(want) {
+		t.Errorf("BoolResampler.Count() = %v, want %v", got, want)
+	}
+	if got, want := data.AsFloat64().AsBool(), data; !got.Equals(want) {
+		t.Errorf("Data.AsBool() = %v, want %v", got, want)
+	}
+}
@@ -4,58 +4,65 @@ import (
 	"sort"
 )
 
-type DTypeSlice []DType
+type FloatSlice[T Float] []T
 
-func (x DTypeSlice) Len() int { return len(x) }
-func (x DTypeSlice) Less(i, j int) bool {
+func (x FloatSlice[T]) Len() int { return len(x) }
+func (x FloatSlice[T]) Less(i, j int) bool {
 	return x[i] < x[j] || (IsNA(x[i]) && !IsNA(x[j]))
 }
-func (x DTypeSlice) Swap(i, j int) { x[i], x[j] = x[j], x[i] }
+func (x FloatSlice[T]) Swap(i, j int) { x[i], x[j] = x[j], x[i] }
 
-type sortable Data
+type sortable[T Float] Data[T]
 
-func (x sortable) Len() int { return len(x.values) }
+func (x sortable[T]) Len() int { return len(x.values) }
 
-func (x sortable) Less(i, j int) bool {
-	return x.values[i] < x.values[j] || (IsNA(x.values[i]) && !IsNA(x.values[j]))
+func (x sortable[T]) Less(i, j int) bool {
+	naI, naJ := Data[T](x).isNA(i), Data[T](x).isNA(j)
+	return (!naI && !naJ && x.values[i] < x.values[j]) || (naI && !naJ)
 }
 
-func (x sortable) Swap(i, j int) {
+func (x sortable[T]) Swap(i, j int) {
 	x.values[i], x.values[j] = x.values[j], x.values[i]
 	x.index[i], x.index[j] = x.index[j], x.index[i]
+	if !x.valid.IsZero() {
+		x.valid.Swap(i, j)
+	}
 }
 
-type argSortable Data
+type argSortable[T Float] Data[T]
 
-func (x argSortable) Len() int { return len(x.index) }
+func (x argSortable[T]) Len() int { return len(x.index) }
 
-func (x argSortable) Less(i, j int) bool { return x.index[i] < x.index[j] }
+func (x argSortable[T]) Less(i, j int) bool { return x.index[i] < x.index[j] }
 
-func (x argSortable) Swap(i, j int) {
+func (x argSortable[T]) Swap(i, j int) {
 	x.values[i], x.values[j] = x.values[j], x.values[i]
 	x.index[i], x.index[j] = x.index[j], x.index[i]
+	if !x.valid.IsZero() {
+		x.valid.Swap(i, j)
+	}
 }
 
 // IndexSort sorts data's index.
-func (d Data) IndexSort() Data {
-	sort.Sort(argSortable(d))
+func (d Data[T]) IndexSort() Data[T] {
+	sort.Sort(argSortable[T](d))
 	return d
 }
 
 // Sort sorts data.
-func (d Data) Sort() Data {
-	sort.Sort(sortable(d))
+func (d Data[T]) Sort() Data[T] {
+	sort.Sort(sortable[T](d))
 	return d
 }
 
 // IndexSortStable sorts data's index using stable sort algorithm.
-func (d Data) IndexSortStable() Data {
-	sort.Stable(argSortable(d))
+func (d Data[T]) IndexSortStable() Data[T] {
+	sort.Stable(argSortable[T](d))
 	return d
 }
 
 // SortStable sorts data's index using stable sort algorithm.
-func (d Data) SortStable() Data {
-	sort.Stable(sortable(d))
+func (d Data[T]) SortStable() Data[T] {
+	sort.Stable(sortable[T](d))
 	return d
 }
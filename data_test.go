@@ -11,7 +11,7 @@ func TestData_Resample_Interpolate(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
 		freq   int64
@@ -22,21 +22,21 @@ func TestData_Resample_Interpolate(t *testing.T) {
 		name   string
 		fields fields
 		args   args
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"freq=2 to 1 length upsample",
-			fields{2, []int64{2, 4, 6, 8}, []DType{2, 4, 6, 8}},
+			fields{2, []int64{2, 4, 6, 8}, []float64{2, 4, 6, 8}},
 			args{
 				freq:   1,
 				origin: OriginStart,
 				method: InterpolationNone,
 			},
-			MakeData(1, []int64{2, 3, 4, 5, 6, 7, 8}, []DType{2, NaN, 4, NaN, 6, NaN, 8}),
+			MakeData(1, []int64{2, 3, 4, 5, 6, 7, 8}, []float64{2, NaN, 4, NaN, 6, NaN, 8}),
 		},
 		{
 			"freq=4 to 1 length upsample",
-			fields{4, []int64{0, 4, 8, 12, 16}, []DType{0, 4, 8, 12, 16}},
+			fields{4, []int64{0, 4, 8, 12, 16}, []float64{0, 4, 8, 12, 16}},
 			args{
 				freq:   1,
 				origin: OriginStart,
@@ -45,12 +45,12 @@ func TestData_Resample_Interpolate(t *testing.T) {
 			MakeData(
 				1,
 				[]int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
-				[]DType{0, NaN, NaN, NaN, 4, NaN, NaN, NaN, 8, NaN, NaN, NaN, 12, NaN, NaN, NaN, 16},
+				[]float64{0, NaN, NaN, NaN, 4, NaN, NaN, NaN, 8, NaN, NaN, NaN, 12, NaN, NaN, NaN, 16},
 			),
 		},
 		{
 			"freq=4 to 1 length upsample lerp",
-			fields{4, []int64{0, 4, 8, 12, 16}, []DType{0, 4, 8, 12, 16}},
+			fields{4, []int64{0, 4, 8, 12, 16}, []float64{0, 4, 8, 12, 16}},
 			args{
 				freq:   1,
 				origin: OriginStart,
@@ -59,13 +59,13 @@ func TestData_Resample_Interpolate(t *testing.T) {
 			MakeData(
 				1,
 				[]int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
-				[]DType{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+				[]float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
 			),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -77,6 +77,30 @@ func TestData_Resample_Interpolate(t *testing.T) {
 	}
 }
 
+func TestData_Resample_Interpolate_Irregular(t *testing.T) {
+	data := MakeData(3, []int64{0, 3, 4, 10}, []float64{0, 3, 4, 10})
+
+	tests := []struct {
+		name   string
+		method InterpolationMethod
+		want   []float64
+	}{
+		{"none", InterpolationNone, []float64{0, NaN, NaN, 3, 4, NaN, NaN, NaN, NaN, NaN, 10}},
+		{"pad", InterpolationPad, []float64{0, 0, 0, 3, 4, 4, 4, 4, 4, 4, 10}},
+		{"linear", InterpolationLinear, []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+		{"backfill", InterpolationBackfill, []float64{0, 3, 3, 3, 4, 10, 10, 10, 10, 10, 10}},
+		{"spline", InterpolationSpline, []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := MakeData(1, []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, tt.want)
+			if got := data.Resample(1, OriginStart).Interpolate(tt.method); !got.Equals(want, EpsFp64) {
+				t.Errorf("Resampler.Interpolate() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
 func TestData_Resample(t *testing.T) {
 	const (
 		second = int64(time.Second)
@@ -92,7 +116,7 @@ func TestData_Resample(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
 		freq   int64
@@ -102,25 +126,25 @@ func TestData_Resample(t *testing.T) {
 		name   string
 		fields fields
 		args   args
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"even length",
-			fields{1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 3, 4, 5, 6}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6}},
 			args{
 				freq:   2,
 				origin: OriginStart,
 			},
-			MakeData(2, []int64{1, 3, 5}, []DType{3, 7, 11}),
+			MakeData(2, []int64{1, 3, 5}, []float64{3, 7, 11}),
 		},
 		{
 			"odd length",
-			fields{1, []int64{1, 2, 3, 4, 5, 6, 7}, []DType{1, 2, 3, 4, 5, 6, 7}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6, 7}, []float64{1, 2, 3, 4, 5, 6, 7}},
 			args{
 				freq:   2,
 				origin: OriginStart,
 			},
-			MakeData(2, []int64{1, 3, 5, 7}, []DType{3, 7, 11, 7}),
+			MakeData(2, []int64{1, 3, 5, 7}, []float64{3, 7, 11, 7}),
 		},
 		{
 			"even length minutes freq",
@@ -134,7 +158,7 @@ func TestData_Resample(t *testing.T) {
 					5 * minute,
 					6 * minute,
 				},
-				[]DType{1, 2, 3, 4, 5, 6},
+				[]float64{1, 2, 3, 4, 5, 6},
 			},
 			args{
 				freq:   2 * minute,
@@ -147,7 +171,7 @@ func TestData_Resample(t *testing.T) {
 					3 * minute,
 					5 * minute,
 				},
-				[]DType{3, 7, 11},
+				[]float64{3, 7, 11},
 			),
 		},
 		{
@@ -163,7 +187,7 @@ func TestData_Resample(t *testing.T) {
 					6 * minute,
 					7 * minute,
 				},
-				[]DType{1, 2, 3, 4, 5, 6, 7},
+				[]float64{1, 2, 3, 4, 5, 6, 7},
 			},
 			args{
 				freq:   2 * minute,
@@ -177,7 +201,7 @@ func TestData_Resample(t *testing.T) {
 					5 * minute,
 					7 * minute,
 				},
-				[]DType{3, 7, 11, 7},
+				[]float64{3, 7, 11, 7},
 			),
 		},
 		{
@@ -192,7 +216,7 @@ func TestData_Resample(t *testing.T) {
 					dayStart + 5*minute,
 					dayStart + 6*minute,
 				},
-				[]DType{1, 2, 3, 4, 5, 6},
+				[]float64{1, 2, 3, 4, 5, 6},
 			},
 			args{
 				freq:   2 * minute,
@@ -206,7 +230,7 @@ func TestData_Resample(t *testing.T) {
 					nearestFrameBegin + 4*minute,
 					nearestFrameBegin + 6*minute,
 				},
-				[]DType{1, 5, 9, 6},
+				[]float64{1, 5, 9, 6},
 			),
 		},
 		{
@@ -220,7 +244,7 @@ func TestData_Resample(t *testing.T) {
 					dayStart + 5*minute,
 					dayStart + 6*minute,
 				},
-				[]DType{1, 2, 3, 5, 6},
+				[]float64{1, 2, 3, 5, 6},
 			},
 			args{
 				freq:   2 * minute,
@@ -234,7 +258,7 @@ func TestData_Resample(t *testing.T) {
 					nearestFrameBegin + 4*minute,
 					nearestFrameBegin + 6*minute,
 				},
-				[]DType{1, 5, 5, 6},
+				[]float64{1, 5, 5, 6},
 			),
 		},
 		{
@@ -249,7 +273,7 @@ func TestData_Resample(t *testing.T) {
 					dayStart + 5*minute,
 					dayStart + 6*minute,
 				},
-				[]DType{1, 2, 3, 4, 5, 6},
+				[]float64{1, 2, 3, 4, 5, 6},
 			},
 			args{
 				freq:   2 * minute,
@@ -263,13 +287,13 @@ func TestData_Resample(t *testing.T) {
 					dayStart + 4*minute,
 					dayStart + 6*minute,
 				},
-				[]DType{1, 5, 9, 6},
+				[]float64{1, 5, 9, 6},
 			),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -281,11 +305,292 @@ func TestData_Resample(t *testing.T) {
 	}
 }
 
+func TestData_Resample_Location(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	at := func(loc *time.Location, month time.Month, day, hour, min int) int64 {
+		return time.Date(2023, month, day, hour, min, 0, 0, loc).UnixNano()
+	}
+
+	tests := []struct {
+		name   string
+		index  []int64
+		freq   int64
+		origin ResampleOrigin
+		opts   []ResampleOption
+		want   Data[float64]
+	}{
+		{
+			"daily bars cut at local midnight",
+			[]int64{at(tokyo, 3, 1, 1, 0), at(tokyo, 3, 1, 23, 0), at(tokyo, 3, 2, 8, 0)},
+			int64(24 * time.Hour),
+			OriginEpoch,
+			[]ResampleOption{WithLocation(tokyo)},
+			MakeData(int64(24*time.Hour), []int64{at(tokyo, 3, 1, 0, 0), at(tokyo, 3, 2, 0, 0)}, []float64{3, 3}),
+		},
+		{
+			"23 hours day of spring DST transition",
+			[]int64{at(newYork, 3, 11, 12, 0), at(newYork, 3, 12, 1, 0), at(newYork, 3, 12, 23, 30), at(newYork, 3, 13, 0, 30)},
+			int64(24 * time.Hour),
+			OriginStartDay,
+			[]ResampleOption{WithLocation(newYork)},
+			MakeData(
+				int64(24*time.Hour),
+				[]int64{at(newYork, 3, 11, 0, 0), at(newYork, 3, 12, 0, 0), at(newYork, 3, 13, 0, 0)},
+				[]float64{1, 5, 4},
+			),
+		},
+		{
+			"25 hours day of autumn DST transition",
+			[]int64{at(newYork, 11, 5, 0, 30), at(newYork, 11, 5, 23, 30), at(newYork, 11, 6, 0, 30)},
+			int64(24 * time.Hour),
+			OriginEpoch,
+			[]ResampleOption{WithLocation(newYork)},
+			MakeData(int64(24*time.Hour), []int64{at(newYork, 11, 5, 0, 0), at(newYork, 11, 6, 0, 0)}, []float64{3, 3}),
+		},
+		{
+			"hours from start of the day",
+			[]int64{at(time.UTC, 5, 7, 10, 30), at(time.UTC, 5, 7, 11, 15), at(time.UTC, 5, 7, 11, 45)},
+			int64(time.Hour),
+			OriginStartDay,
+			nil,
+			MakeData(int64(time.Hour), []int64{at(time.UTC, 5, 7, 10, 0), at(time.UTC, 5, 7, 11, 0)}, []float64{1, 5}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := make([]float64, len(tt.index))
+			for i := range values {
+				values[i] = float64(i + 1)
+			}
+			d := MakeData(int64(time.Minute), tt.index, values)
+
+			if got := d.Resample(tt.freq, tt.origin, tt.opts...).Sum(); !got.Equals(tt.want, EpsFp64) {
+				t.Errorf("Data.Resample() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestData_Resample_Options(t *testing.T) {
+	at := func(day, hour, min int) int64 {
+		return time.Date(2000, time.October, day, hour, min, 0, 0, time.UTC).UnixNano()
+	}
+
+	minutely := make([]int64, 9)
+	for i := range minutely {
+		minutely[i] = at(2, 0, i)
+	}
+
+	irregular := make([]int64, 9)
+	for i := range irregular {
+		irregular[i] = at(1, 23, 30+7*i)
+	}
+
+	tests := []struct {
+		name   string
+		index  []int64
+		values []float64
+		freq   int64
+		origin ResampleOrigin
+		opts   []ResampleOption
+		want   Data[float64]
+	}{
+		{
+			"closed right",
+			minutely,
+			[]float64{0, 1, 2, 3, 4, 5, 6, 7, 8},
+			int64(3 * time.Minute),
+			OriginStartDay,
+			[]ResampleOption{WithClosed(SideRight)},
+			MakeData(int64(3*time.Minute), []int64{at(1, 23, 57), at(2, 0, 0), at(2, 0, 3), at(2, 0, 6)}, []float64{0, 6, 15, 15}),
+		},
+		{
+			"label right",
+			minutely,
+			[]float64{0, 1, 2, 3, 4, 5, 6, 7, 8},
+			int64(3 * time.Minute),
+			OriginStartDay,
+			[]ResampleOption{WithLabel(SideRight)},
+			MakeData(int64(3*time.Minute), []int64{at(2, 0, 3), at(2, 0, 6), at(2, 0, 9)}, []float64{3, 12, 21}),
+		},
+		{
+			"closed and label right",
+			minutely,
+			[]float64{0, 1, 2, 3, 4, 5, 6, 7, 8},
+			int64(3 * time.Minute),
+			OriginStartDay,
+			[]ResampleOption{WithClosed(SideRight), WithLabel(SideRight)},
+			MakeData(int64(3*time.Minute), []int64{at(2, 0, 0), at(2, 0, 3), at(2, 0, 6), at(2, 0, 9)}, []float64{0, 6, 15, 15}),
+		},
+		{
+			"start day",
+			irregular,
+			[]float64{0, 3, 6, 9, 12, 15, 18, 21, 24},
+			int64(17 * time.Minute),
+			OriginStartDay,
+			nil,
+			MakeData(int64(17*time.Minute), []int64{at(1, 23, 14), at(1, 23, 31), at(1, 23, 48), at(2, 0, 5), at(2, 0, 22)}, []float64{0, 9, 21, 54, 24}),
+		},
+		{
+			"epoch",
+			irregular,
+			[]float64{0, 3, 6, 9, 12, 15, 18, 21, 24},
+			int64(17 * time.Minute),
+			OriginEpoch,
+			nil,
+			MakeData(int64(17*time.Minute), []int64{at(1, 23, 18), at(1, 23, 35), at(1, 23, 52), at(2, 0, 9), at(2, 0, 26)}, []float64{0, 18, 27, 39, 24}),
+		},
+		{
+			"offset",
+			irregular,
+			[]float64{0, 3, 6, 9, 12, 15, 18, 21, 24},
+			int64(17 * time.Minute),
+			OriginStartDay,
+			[]ResampleOption{WithOffset(2 * time.Minute)},
+			MakeData(int64(17*time.Minute), []int64{at(1, 23, 16), at(1, 23, 33), at(1, 23, 50), at(2, 0, 7), at(2, 0, 24)}, []float64{0, 9, 36, 39, 24}),
+		},
+		{
+			"custom origin",
+			irregular,
+			[]float64{0, 3, 6, 9, 12, 15, 18, 21, 24},
+			int64(17 * time.Minute),
+			OriginEpoch,
+			[]ResampleOption{WithOrigin(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC).UnixNano())},
+			MakeData(int64(17*time.Minute), []int64{at(1, 23, 24), at(1, 23, 41), at(1, 23, 58), at(2, 0, 15)}, []float64{3, 15, 45, 45}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := MakeData(int64(time.Minute), tt.index, tt.values)
+
+			if got := d.Resample(tt.freq, tt.origin, tt.opts...).Sum(); !got.Equals(tt.want, EpsFp64) {
+				t.Errorf("Data.Resample() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestData_Resample_OHLC(t *testing.T) {
+	data := MakeDataValid(
+		1,
+		[]int64{1, 2, 3, 4, 5, 6, 7, 8, 11},
+		[]float64{5, 7, 3, 6, 100, 4, 4, 2, 8},
+		makeBitmap(true, true, true, true, false, true, true, true, true),
+	)
+
+	want := MakeFrameFromData(
+		[]string{"open", "high", "low", "close"},
+		MakeDataValid(4, []int64{1, 5, 9}, []float64{5, 4, 8}, makeBitmap(true, true, true)),
+		MakeDataValid(4, []int64{1, 5, 9}, []float64{7, 4, 8}, makeBitmap(true, true, true)),
+		MakeDataValid(4, []int64{1, 5, 9}, []float64{3, 2, 8}, makeBitmap(true, true, true)),
+		MakeDataValid(4, []int64{1, 5, 9}, []float64{6, 2, 8}, makeBitmap(true, true, true)),
+	)
+
+	got := data.Resample(4, OriginStart).OHLC()
+	if !got.Equals(want, EpsFp64) {
+		t.Fatalf("Resampler.OHLC() = %v, want %v", got, want)
+	}
+
+	for _, name := range got.Names() {
+		var agg func(Resampler[float64]) Data[float64]
+
+		switch name {
+		case "open":
+			agg = Resampler[float64].First
+		case "high":
+			agg = Resampler[float64].Max
+		case "low":
+			agg = Resampler[float64].Min
+		case "close":
+			agg = Resampler[float64].Last
+		}
+
+		if want := agg(data.Resample(4, OriginStart)); !got.Column(name).Equals(want, EpsFp64) {
+			t.Errorf("Resampler.OHLC() %s = %v, want %v", name, got.Column(name), want)
+		}
+	}
+}
+
+func TestData_Resample_Agg(t *testing.T) {
+	data := MakeData(1, []int64{1, 2, 3, 4, 5, 6, 9}, []float64{1, 2, 3, 4, NaN, 6, 7})
+	res := data.Resample(2, OriginStart)
+
+	fns := []AggregateFunc[float64]{Mean[float64], Min[float64], Max[float64]}
+	want := []Data[float64]{res.Mean(), res.Min(), res.Max()}
+
+	got := res.Agg(fns...)
+	if len(got) != len(want) {
+		t.Fatalf("len(Resampler.Agg()) = %v, want %v", len(got), len(want))
+	}
+	for i := range want {
+		if !got[i].Equals(want[i], EpsFp64) {
+			t.Errorf("Resampler.Agg()[%d] = %v, want %v", i, got[i], want[i])
+		}
+		if &got[i].Index()[0] != &got[0].Index()[0] {
+			t.Errorf("Resampler.Agg()[%d] index is not shared", i)
+		}
+	}
+
+	empty := MakeData(1, []int64{}, []float64{}).Resample(2, OriginStart).Agg(fns...)
+	for i := range empty {
+		if empty[i].Len() != 0 || empty[i].Freq() != 2 {
+			t.Errorf("Resampler.Agg()[%d] of empty data = %v with freq %d, want empty data with freq 2", i, empty[i], empty[i].Freq())
+		}
+	}
+
+	frame := res.AggMap(map[string]AggregateFunc[float64]{
+		"min":  Min[float64],
+		"mean": Mean[float64],
+	})
+	wantFrame := MakeFrameFromData([]string{"mean", "min"}, res.Mean(), res.Min())
+
+	if !frame.Equals(wantFrame, EpsFp64) {
+		t.Errorf("Resampler.AggMap() = %v, want %v", frame, wantFrame)
+	}
+}
+
+func TestData_Resample_Statistics(t *testing.T) {
+	data := MakeData(1, []int64{1, 2, 3, 4, 5, 6, 9}, []float64{1, 2, 2, NaN, 4, 6, 7})
+	res := data.Resample(3, OriginStart)
+
+	index := []int64{1, 4, 7}
+
+	tests := []struct {
+		name string
+		got  Data[float64]
+		want []float64
+	}{
+		{"count", res.Count(), []float64{3, 2, 1}},
+		{"prod", res.Prod(), []float64{4, 24, 7}},
+		{"nunique", res.NUnique(), []float64{2, 2, 1}},
+		{"var", res.Var(1), []float64{1.0 / 3, 2, NaN}},
+		{"std", res.Std(0), []float64{math.Sqrt(2.0 / 9), 1, 0}},
+		{"sem", res.Sem(1), []float64{1.0 / 3, 1, NaN}},
+		{"quantile", res.Quantile(0.5, QuantileLower), []float64{2, 4, 7}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := MakeData(3, index, tt.want)
+			if !tt.got.Equals(want, EpsFp64) {
+				t.Errorf("Resampler = %v, want %v", tt.got, want)
+			}
+		})
+	}
+}
+
 func TestData_ResampleMedian(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
 		freq   int64
@@ -295,39 +600,39 @@ func TestData_ResampleMedian(t *testing.T) {
 		name   string
 		fields fields
 		args   args
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"even length reversed",
-			fields{1, []int64{1, 2, 3, 4, 5, 6}, []DType{6, 5, 4, 3, 2, 1}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6}, []float64{6, 5, 4, 3, 2, 1}},
 			args{
 				freq:   3,
 				origin: OriginStart,
 			},
-			MakeData(3, []int64{1, 4}, []DType{5, 2}),
+			MakeData(3, []int64{1, 4}, []float64{5, 2}),
 		},
 		{
 			"even length",
-			fields{1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 3, 4, 5, 6}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6}},
 			args{
 				freq:   3,
 				origin: OriginStart,
 			},
-			MakeData(3, []int64{1, 4}, []DType{2, 5}),
+			MakeData(3, []int64{1, 4}, []float64{2, 5}),
 		},
 		{
 			"odd length",
-			fields{1, []int64{1, 2, 3, 4, 5, 6, 7}, []DType{1, 2, 3, 4, 5, 6, 7}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6, 7}, []float64{1, 2, 3, 4, 5, 6, 7}},
 			args{
 				freq:   3,
 				origin: OriginStart,
 			},
-			MakeData(3, []int64{1, 4, 7}, []DType{2, 5, 7}),
+			MakeData(3, []int64{1, 4, 7}, []float64{2, 5, 7}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -343,29 +648,29 @@ func TestData_Add(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
-		r Data
+		r Data[float64]
 	}
 	tests := []struct {
 		name   string
 		fields fields
 		args   args
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"even length",
-			fields{1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 3, 4, 5, 6}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6}},
 			args{
-				MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 3, 4, 5, 6}),
+				MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6}),
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{2, 4, 6, 8, 10, 12}),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{2, 4, 6, 8, 10, 12}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -381,29 +686,29 @@ func TestData_Sub(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
-		r Data
+		r Data[float64]
 	}
 	tests := []struct {
 		name   string
 		fields fields
 		args   args
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"even length",
-			fields{1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 3, 4, 5, 6}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6}},
 			args{
-				MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 3, 4, 5, 6}),
+				MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6}),
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{0, 0, 0, 0, 0, 0}),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{0, 0, 0, 0, 0, 0}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -419,29 +724,29 @@ func TestData_Mul(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
-		r Data
+		r Data[float64]
 	}
 	tests := []struct {
 		name   string
 		fields fields
 		args   args
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"even length",
-			fields{1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 3, 4, 5, 6}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6}},
 			args{
-				MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 3, 4, 5, 6}),
+				MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6}),
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 4, 9, 16, 25, 36}),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 4, 9, 16, 25, 36}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -457,29 +762,29 @@ func TestData_Div(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
-		r Data
+		r Data[float64]
 	}
 	tests := []struct {
 		name   string
 		fields fields
 		args   args
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"even length",
-			fields{1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 3, 4, 5, 6}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6}},
 			args{
-				MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 3, 4, 5, 6}),
+				MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6}),
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 1, 1, 1, 1, 1}),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 1, 1, 1, 1, 1}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -495,45 +800,45 @@ func TestData_AddScalar(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
-		s DType
+		s float64
 	}
 	tests := []struct {
 		name   string
 		fields fields
 		args   args
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"even length",
-			fields{1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 3, 4, 5, 6}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6}},
 			args{
 				4,
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{5, 6, 7, 8, 9, 10}),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{5, 6, 7, 8, 9, 10}),
 		},
 		{
 			"8 length",
-			fields{1, []int64{1, 2, 3, 4, 5, 6, 7, 8}, []DType{1, 2, 3, 4, 5, 6, 7, 8}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6, 7, 8}, []float64{1, 2, 3, 4, 5, 6, 7, 8}},
 			args{
 				4,
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7, 8}, []DType{5, 6, 7, 8, 9, 10, 11, 12}),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7, 8}, []float64{5, 6, 7, 8, 9, 10, 11, 12}),
 		},
 		{
 			"16 length",
-			fields{1, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, []DType{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}},
 			args{
 				4,
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, []DType{5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, []float64{5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -549,29 +854,29 @@ func TestData_SubScalar(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
-		s DType
+		s float64
 	}
 	tests := []struct {
 		name   string
 		fields fields
 		args   args
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"even length",
-			fields{1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 3, 4, 5, 6}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6}},
 			args{
 				4,
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{-3, -2, -1, 0, 1, 2}),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{-3, -2, -1, 0, 1, 2}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -587,29 +892,29 @@ func TestData_MulScalar(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
-		s DType
+		s float64
 	}
 	tests := []struct {
 		name   string
 		fields fields
 		args   args
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"even length",
-			fields{1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 3, 4, 5, 6}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6}},
 			args{
 				4,
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{4, 8, 12, 16, 20, 24}),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{4, 8, 12, 16, 20, 24}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -625,29 +930,29 @@ func TestData_DivScalar(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
-		s DType
+		s float64
 	}
 	tests := []struct {
 		name   string
 		fields fields
 		args   args
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"even length",
-			fields{1, []int64{1, 2, 3, 4, 5, 6}, []DType{2, 4, 8, 12, 14, 16}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6}, []float64{2, 4, 8, 12, 14, 16}},
 			args{
 				2,
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 4, 6, 7, 8}),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 4, 6, 7, 8}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -663,34 +968,34 @@ func TestData_Fillna(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
-		value   DType
+		value   float64
 		inplace bool
 	}
 	tests := []struct {
 		name   string
 		fields fields
 		args   args
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			name: "simple fillna",
 			fields: fields{
 				freq:   1,
 				index:  []int64{1, 2, 3, 4, 5},
-				values: []DType{NaN, NaN, 5, 2, NaN},
+				values: []float64{NaN, NaN, 5, 2, NaN},
 			},
 			args: args{
 				value: 0,
 			},
-			want: MakeData(1, []int64{1, 2, 3, 4, 5}, []DType{0, 0, 5, 2, 0}),
+			want: MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{0, 0, 5, 2, 0}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -706,89 +1011,89 @@ func TestData_Pad(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			name: "complex pad",
 			fields: fields{
 				freq:   1,
 				index:  []int64{-2, -1, 0, 1, 2, 3, 4, 5},
-				values: []DType{NaN, NaN, 0, NaN, NaN, 5, 2, NaN},
+				values: []float64{NaN, NaN, 0, NaN, NaN, 5, 2, NaN},
 			},
-			want: MakeData(1, []int64{-2, -1, 0, 1, 2, 3, 4, 5}, []DType{0, 0, 0, 0, 0, 5, 2, 2}),
+			want: MakeData(1, []int64{-2, -1, 0, 1, 2, 3, 4, 5}, []float64{0, 0, 0, 0, 0, 5, 2, 2}),
 		},
 		{
 			name: "all NaN",
 			fields: fields{
 				freq:   1,
 				index:  []int64{0, 1, 2, 3, 4, 5},
-				values: []DType{NaN, NaN, NaN, NaN, NaN, NaN},
+				values: []float64{NaN, NaN, NaN, NaN, NaN, NaN},
 			},
-			want: MakeData(1, []int64{0, 1, 2, 3, 4, 5}, []DType{NaN, NaN, NaN, NaN, NaN, NaN}),
+			want: MakeData(1, []int64{0, 1, 2, 3, 4, 5}, []float64{NaN, NaN, NaN, NaN, NaN, NaN}),
 		},
 		{
 			name: "between NaNs",
 			fields: fields{
 				freq:   1,
 				index:  []int64{0, 1, 2, 3, 4, 5},
-				values: []DType{NaN, NaN, 1, 9, NaN, NaN},
+				values: []float64{NaN, NaN, 1, 9, NaN, NaN},
 			},
-			want: MakeData(1, []int64{0, 1, 2, 3, 4, 5}, []DType{1, 1, 1, 9, 9, 9}),
+			want: MakeData(1, []int64{0, 1, 2, 3, 4, 5}, []float64{1, 1, 1, 9, 9, 9}),
 		},
 		{
 			name: "NaN at mid",
 			fields: fields{
 				freq:   1,
 				index:  []int64{0, 1, 2, 3, 4, 5},
-				values: []DType{0, 1, 2, NaN, 4, 5},
+				values: []float64{0, 1, 2, NaN, 4, 5},
 			},
-			want: MakeData(1, []int64{0, 1, 2, 3, 4, 5}, []DType{0, 1, 2, 2, 4, 5}),
+			want: MakeData(1, []int64{0, 1, 2, 3, 4, 5}, []float64{0, 1, 2, 2, 4, 5}),
 		},
 		{
 			name: "NaN at begin",
 			fields: fields{
 				freq:   1,
 				index:  []int64{0, 1, 2, 3, 4, 5},
-				values: []DType{NaN, NaN, 2, 3, 4, 5},
+				values: []float64{NaN, NaN, 2, 3, 4, 5},
 			},
-			want: MakeData(1, []int64{0, 1, 2, 3, 4, 5}, []DType{2, 2, 2, 3, 4, 5}),
+			want: MakeData(1, []int64{0, 1, 2, 3, 4, 5}, []float64{2, 2, 2, 3, 4, 5}),
 		},
 		{
 			name: "NaN at begin",
 			fields: fields{
 				freq:   1,
 				index:  []int64{0, 1, 2, 3, 4, 5},
-				values: []DType{0, 1, 2, 3, NaN, NaN},
+				values: []float64{0, 1, 2, 3, NaN, NaN},
 			},
-			want: MakeData(1, []int64{0, 1, 2, 3, 4, 5}, []DType{0, 1, 2, 3, 3, 3}),
+			want: MakeData(1, []int64{0, 1, 2, 3, 4, 5}, []float64{0, 1, 2, 3, 3, 3}),
 		},
 		{
 			name: "NaN last",
 			fields: fields{
 				freq:   1,
 				index:  []int64{0, 1, 2, 3, 4, 5},
-				values: []DType{0, 1, 2, 3, 4, NaN},
+				values: []float64{0, 1, 2, 3, 4, NaN},
 			},
-			want: MakeData(1, []int64{0, 1, 2, 3, 4, 5}, []DType{0, 1, 2, 3, 4, 4}),
+			want: MakeData(1, []int64{0, 1, 2, 3, 4, 5}, []float64{0, 1, 2, 3, 4, 4}),
 		},
 		{
 			name: "without NaN",
 			fields: fields{
 				freq:   1,
 				index:  []int64{0, 1, 2, 3, 4, 5},
-				values: []DType{0, 1, 2, 3, 4, 5},
+				values: []float64{0, 1, 2, 3, 4, 5},
 			},
-			want: MakeData(1, []int64{0, 1, 2, 3, 4, 5}, []DType{0, 1, 2, 3, 4, 5}),
+			want: MakeData(1, []int64{0, 1, 2, 3, 4, 5}, []float64{0, 1, 2, 3, 4, 5}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -807,125 +1112,125 @@ func TestData_Shrink(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			name: "complex",
 			fields: fields{
 				freq:   1,
 				index:  []int64{-2, -1, 0, 1, 2, 3, 4, 5},
-				values: []DType{NaN, NaN, 0, NaN, NaN, 5, 2, NaN},
+				values: []float64{NaN, NaN, 0, NaN, NaN, 5, 2, NaN},
 			},
-			want: MakeData(1, []int64{0, 3, 4}, []DType{0, 5, 2}),
+			want: MakeData(1, []int64{0, 3, 4}, []float64{0, 5, 2}),
 		},
 		{
 			name: "complex 2",
 			fields: fields{
 				freq:   1,
 				index:  []int64{1, 2, 3, 4, 5, 6},
-				values: []DType{NaN, 2, NaN, 4, NaN, 6, NaN},
+				values: []float64{NaN, 2, NaN, 4, NaN, 6, NaN},
 			},
-			want: MakeData(1, []int64{2, 4, 6}, []DType{2, 4, 6}),
+			want: MakeData(1, []int64{2, 4, 6}, []float64{2, 4, 6}),
 		},
 		{
 			name: "complex 3",
 			fields: fields{
 				freq:   1,
 				index:  []int64{1, 2, 3, 4, 5, 6, 7},
-				values: []DType{1, NaN, 3, NaN, 5, NaN, 7},
+				values: []float64{1, NaN, 3, NaN, 5, NaN, 7},
 			},
-			want: MakeData(1, []int64{1, 3, 5, 7}, []DType{1, 3, 5, 7}),
+			want: MakeData(1, []int64{1, 3, 5, 7}, []float64{1, 3, 5, 7}),
 		},
 		{
 			name: "complex 4",
 			fields: fields{
 				freq:   1,
 				index:  []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
-				values: []DType{1, NaN, NaN, NaN, 5, 6, 7, NaN, NaN, NaN},
+				values: []float64{1, NaN, NaN, NaN, 5, 6, 7, NaN, NaN, NaN},
 			},
-			want: MakeData(1, []int64{1, 5, 6, 7}, []DType{1, 5, 6, 7}),
+			want: MakeData(1, []int64{1, 5, 6, 7}, []float64{1, 5, 6, 7}),
 		},
 		{
 			name: "complex 5",
 			fields: fields{
 				freq:   1,
 				index:  []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13},
-				values: []DType{1, NaN, NaN, NaN, 5, 6, 7, NaN, NaN, NaN, 11, 12, 13},
+				values: []float64{1, NaN, NaN, NaN, 5, 6, 7, NaN, NaN, NaN, 11, 12, 13},
 			},
-			want: MakeData(1, []int64{1, 5, 6, 7, 11, 12, 13}, []DType{1, 5, 6, 7, 11, 12, 13}),
+			want: MakeData(1, []int64{1, 5, 6, 7, 11, 12, 13}, []float64{1, 5, 6, 7, 11, 12, 13}),
 		},
 		{
 			name: "all NaN",
 			fields: fields{
 				freq:   1,
 				index:  []int64{0, 1, 2, 3, 4, 5},
-				values: []DType{NaN, NaN, NaN, NaN, NaN, NaN},
+				values: []float64{NaN, NaN, NaN, NaN, NaN, NaN},
 			},
-			want: MakeData(1, []int64{}, []DType{}),
+			want: MakeData(1, []int64{}, []float64{}),
 		},
 		{
 			name: "between NaNs",
 			fields: fields{
 				freq:   1,
 				index:  []int64{0, 1, 2, 3, 4, 5},
-				values: []DType{NaN, NaN, 1, 9, NaN, NaN},
+				values: []float64{NaN, NaN, 1, 9, NaN, NaN},
 			},
-			want: MakeData(1, []int64{2, 3}, []DType{1, 9}),
+			want: MakeData(1, []int64{2, 3}, []float64{1, 9}),
 		},
 		{
 			name: "NaN at mid",
 			fields: fields{
 				freq:   1,
 				index:  []int64{0, 1, 2, 3, 4, 5},
-				values: []DType{0, 1, 2, NaN, 4, 5},
+				values: []float64{0, 1, 2, NaN, 4, 5},
 			},
-			want: MakeData(1, []int64{0, 1, 2, 4, 5}, []DType{0, 1, 2, 4, 5}),
+			want: MakeData(1, []int64{0, 1, 2, 4, 5}, []float64{0, 1, 2, 4, 5}),
 		},
 		{
 			name: "NaN at begin",
 			fields: fields{
 				freq:   1,
 				index:  []int64{0, 1, 2, 3, 4, 5},
-				values: []DType{NaN, NaN, 2, 3, 4, 5},
+				values: []float64{NaN, NaN, 2, 3, 4, 5},
 			},
-			want: MakeData(1, []int64{2, 3, 4, 5}, []DType{2, 3, 4, 5}),
+			want: MakeData(1, []int64{2, 3, 4, 5}, []float64{2, 3, 4, 5}),
 		},
 		{
 			name: "2 NaN at end",
 			fields: fields{
 				freq:   1,
 				index:  []int64{0, 1, 2, 3, 4, 5},
-				values: []DType{0, 1, 2, 3, NaN, NaN},
+				values: []float64{0, 1, 2, 3, NaN, NaN},
 			},
-			want: MakeData(1, []int64{0, 1, 2, 3}, []DType{0, 1, 2, 3}),
+			want: MakeData(1, []int64{0, 1, 2, 3}, []float64{0, 1, 2, 3}),
 		},
 		{
 			name: "NaN last",
 			fields: fields{
 				freq:   1,
 				index:  []int64{0, 1, 2, 3, 4, 5},
-				values: []DType{0, 1, 2, 3, 4, NaN},
+				values: []float64{0, 1, 2, 3, 4, NaN},
 			},
-			want: MakeData(1, []int64{0, 1, 2, 3, 4}, []DType{0, 1, 2, 3, 4}),
+			want: MakeData(1, []int64{0, 1, 2, 3, 4}, []float64{0, 1, 2, 3, 4}),
 		},
 		{
 			name: "without NaN",
 			fields: fields{
 				freq:   1,
 				index:  []int64{0, 1, 2, 3, 4, 5},
-				values: []DType{0, 1, 2, 3, 4, 5},
+				values: []float64{0, 1, 2, 3, 4, 5},
 			},
-			want: MakeData(1, []int64{0, 1, 2, 3, 4, 5}, []DType{0, 1, 2, 3, 4, 5}),
+			want: MakeData(1, []int64{0, 1, 2, 3, 4, 5}, []float64{0, 1, 2, 3, 4, 5}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -944,26 +1249,26 @@ func TestData_Sort(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			name: "simple Sort",
 			fields: fields{
 				freq:   1,
 				index:  []int64{1, 2, 3, 4, 5},
-				values: []DType{NaN, NaN, 5, 2, NaN},
+				values: []float64{NaN, NaN, 5, 2, NaN},
 			},
-			want: MakeData(1, []int64{1, 2, 5, 4, 3}, []DType{NaN, NaN, NaN, 2, 5}),
+			want: MakeData(1, []int64{1, 2, 5, 4, 3}, []float64{NaN, NaN, NaN, 2, 5}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -988,26 +1293,26 @@ func TestData_SortStable(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			name: "simple SortStable",
 			fields: fields{
 				freq:   1,
 				index:  []int64{1, 2, 3, 4, 5},
-				values: []DType{NaN, NaN, 5, 2, NaN},
+				values: []float64{NaN, NaN, 5, 2, NaN},
 			},
-			want: MakeData(1, []int64{1, 2, 5, 4, 3}, []DType{NaN, NaN, NaN, 2, 5}),
+			want: MakeData(1, []int64{1, 2, 5, 4, 3}, []float64{NaN, NaN, NaN, 2, 5}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -1032,29 +1337,29 @@ func TestData_IndexSort(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			name: "simple IndexSort",
 			fields: fields{
 				freq:   1,
 				index:  []int64{4, 1, 3, 2, 5},
-				values: []DType{2, NaN, 5, NaN, NaN},
+				values: []float64{2, NaN, 5, NaN, NaN},
 			},
 			want: MakeData(
 				1,
 				[]int64{1, 2, 3, 4, 5},
-				[]DType{NaN, NaN, 5, 2, NaN}),
+				[]float64{NaN, NaN, 5, 2, NaN}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -1073,29 +1378,29 @@ func TestData_IndexSortStable(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			name: "simple IndexSortStable",
 			fields: fields{
 				freq:   1,
 				index:  []int64{4, 1, 3, 2, 5},
-				values: []DType{2, NaN, 5, NaN, NaN},
+				values: []float64{2, NaN, 5, NaN, NaN},
 			},
 			want: MakeData(
 				1,
 				[]int64{1, 2, 3, 4, 5},
-				[]DType{NaN, NaN, 5, 2, NaN}),
+				[]float64{NaN, NaN, 5, 2, NaN}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -1114,42 +1419,42 @@ func TestData_Reverse(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"len == 1",
-			fields{1, []int64{1}, []DType{1}},
-			MakeData(1, []int64{1}, []DType{1}),
+			fields{1, []int64{1}, []float64{1}},
+			MakeData(1, []int64{1}, []float64{1}),
 		},
 		{
 			"len == 2",
-			fields{1, []int64{1, 2}, []DType{1, 2}},
-			MakeData(1, []int64{2, 1}, []DType{2, 1}),
+			fields{1, []int64{1, 2}, []float64{1, 2}},
+			MakeData(1, []int64{2, 1}, []float64{2, 1}),
 		},
 		{
 			"len == 3",
-			fields{1, []int64{1, 2, 3}, []DType{1, 2, 3}},
-			MakeData(1, []int64{3, 2, 1}, []DType{3, 2, 1}),
+			fields{1, []int64{1, 2, 3}, []float64{1, 2, 3}},
+			MakeData(1, []int64{3, 2, 1}, []float64{3, 2, 1}),
 		},
 		{
 			"even",
-			fields{1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 3, 4, 5, 6}},
-			MakeData(1, []int64{6, 5, 4, 3, 2, 1}, []DType{6, 5, 4, 3, 2, 1}),
+			fields{1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6}},
+			MakeData(1, []int64{6, 5, 4, 3, 2, 1}, []float64{6, 5, 4, 3, 2, 1}),
 		},
 		{
 			"odd",
-			fields{1, []int64{1, 2, 3, 4, 5, 6, 7}, []DType{1, 2, 3, 4, 5, 6, 7}},
-			MakeData(1, []int64{7, 6, 5, 4, 3, 2, 1}, []DType{7, 6, 5, 4, 3, 2, 1}),
+			fields{1, []int64{1, 2, 3, 4, 5, 6, 7}, []float64{1, 2, 3, 4, 5, 6, 7}},
+			MakeData(1, []int64{7, 6, 5, 4, 3, 2, 1}, []float64{7, 6, 5, 4, 3, 2, 1}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -1165,42 +1470,42 @@ func TestData_IndexReverse(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"len == 1",
-			fields{1, []int64{1}, []DType{1}},
-			MakeData(1, []int64{1}, []DType{1}),
+			fields{1, []int64{1}, []float64{1}},
+			MakeData(1, []int64{1}, []float64{1}),
 		},
 		{
 			"len == 2",
-			fields{1, []int64{1, 2}, []DType{1, 2}},
-			MakeData(1, []int64{2, 1}, []DType{1, 2}),
+			fields{1, []int64{1, 2}, []float64{1, 2}},
+			MakeData(1, []int64{2, 1}, []float64{1, 2}),
 		},
 		{
 			"len == 3",
-			fields{1, []int64{1, 2, 3}, []DType{1, 2, 3}},
-			MakeData(1, []int64{3, 2, 1}, []DType{1, 2, 3}),
+			fields{1, []int64{1, 2, 3}, []float64{1, 2, 3}},
+			MakeData(1, []int64{3, 2, 1}, []float64{1, 2, 3}),
 		},
 		{
 			"even",
-			fields{1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 3, 4, 5, 6}},
-			MakeData(1, []int64{6, 5, 4, 3, 2, 1}, []DType{1, 2, 3, 4, 5, 6}),
+			fields{1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6}},
+			MakeData(1, []int64{6, 5, 4, 3, 2, 1}, []float64{1, 2, 3, 4, 5, 6}),
 		},
 		{
 			"odd",
-			fields{1, []int64{1, 2, 3, 4, 5, 6, 7}, []DType{1, 2, 3, 4, 5, 6, 7}},
-			MakeData(1, []int64{7, 6, 5, 4, 3, 2, 1}, []DType{1, 2, 3, 4, 5, 6, 7}),
+			fields{1, []int64{1, 2, 3, 4, 5, 6, 7}, []float64{1, 2, 3, 4, 5, 6, 7}},
+			MakeData(1, []int64{7, 6, 5, 4, 3, 2, 1}, []float64{1, 2, 3, 4, 5, 6, 7}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -1216,42 +1521,42 @@ func TestData_DataReverse(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"len == 1",
-			fields{1, []int64{1}, []DType{1}},
-			MakeData(1, []int64{1}, []DType{1}),
+			fields{1, []int64{1}, []float64{1}},
+			MakeData(1, []int64{1}, []float64{1}),
 		},
 		{
 			"len == 2",
-			fields{1, []int64{1, 2}, []DType{1, 2}},
-			MakeData(1, []int64{1, 2}, []DType{2, 1}),
+			fields{1, []int64{1, 2}, []float64{1, 2}},
+			MakeData(1, []int64{1, 2}, []float64{2, 1}),
 		},
 		{
 			"len == 3",
-			fields{1, []int64{1, 2, 3}, []DType{1, 2, 3}},
-			MakeData(1, []int64{1, 2, 3}, []DType{3, 2, 1}),
+			fields{1, []int64{1, 2, 3}, []float64{1, 2, 3}},
+			MakeData(1, []int64{1, 2, 3}, []float64{3, 2, 1}),
 		},
 		{
 			"even",
-			fields{1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 2, 3, 4, 5, 6}},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{6, 5, 4, 3, 2, 1}),
+			fields{1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6}},
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{6, 5, 4, 3, 2, 1}),
 		},
 		{
 			"odd",
-			fields{1, []int64{1, 2, 3, 4, 5, 6, 7}, []DType{1, 2, 3, 4, 5, 6, 7}},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []DType{7, 6, 5, 4, 3, 2, 1}),
+			fields{1, []int64{1, 2, 3, 4, 5, 6, 7}, []float64{1, 2, 3, 4, 5, 6, 7}},
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []float64{7, 6, 5, 4, 3, 2, 1}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -1267,7 +1572,7 @@ func TestData_Diff(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
 		period int
@@ -1276,36 +1581,36 @@ func TestData_Diff(t *testing.T) {
 		name   string
 		fields fields
 		args   args
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"data length is less then period length",
-			fields{1, []int64{1, 2}, []DType{1, 1}},
+			fields{1, []int64{1, 2}, []float64{1, 1}},
 			args{3},
-			MakeData(1, []int64{1, 2}, []DType{NaN, NaN}),
+			MakeData(1, []int64{1, 2}, []float64{NaN, NaN}),
 		},
 		{
 			"data length is equal to period length",
-			fields{1, []int64{1, 2, 3}, []DType{1, 1, 2}},
+			fields{1, []int64{1, 2, 3}, []float64{1, 1, 2}},
 			args{3},
-			MakeData(1, []int64{1, 2, 3}, []DType{NaN, NaN, NaN}),
+			MakeData(1, []int64{1, 2, 3}, []float64{NaN, NaN, NaN}),
 		},
 		{
 			"even length",
-			fields{1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 1, 2, 3, 5, 8}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 1, 2, 3, 5, 8}},
 			args{3},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{NaN, NaN, NaN, 2, 4, 6}),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{NaN, NaN, NaN, 2, 4, 6}),
 		},
 		{
 			"diff 1",
-			fields{1, []int64{1, 2, 3, 4, 5, 6}, []DType{1, 1, 2, 3, 5, 8}},
+			fields{1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 1, 2, 3, 5, 8}},
 			args{1},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []DType{NaN, 0, 1, 1, 2, 3}),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{NaN, 0, 1, 1, 2, 3}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -1321,7 +1626,7 @@ func TestData_Shift(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
 		periods int
@@ -1330,54 +1635,54 @@ func TestData_Shift(t *testing.T) {
 		name   string
 		fields fields
 		args   args
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"right shift",
-			fields{1, []int64{1, 2, 3}, []DType{1, 2, 3}},
+			fields{1, []int64{1, 2, 3}, []float64{1, 2, 3}},
 			args{1},
-			MakeData(1, []int64{1, 2, 3}, []DType{NaN, 1, 2}),
+			MakeData(1, []int64{1, 2, 3}, []float64{NaN, 1, 2}),
 		},
 		{
 			"right shift overflow",
-			fields{1, []int64{1, 2, 3}, []DType{1, 2, 3}},
+			fields{1, []int64{1, 2, 3}, []float64{1, 2, 3}},
 			args{4},
-			MakeData(1, []int64{1, 2, 3}, []DType{NaN, NaN, NaN}),
+			MakeData(1, []int64{1, 2, 3}, []float64{NaN, NaN, NaN}),
 		},
 		{
 			"right shift equal to data length",
-			fields{1, []int64{1, 2, 3}, []DType{1, 2, 3}},
+			fields{1, []int64{1, 2, 3}, []float64{1, 2, 3}},
 			args{3},
-			MakeData(1, []int64{1, 2, 3}, []DType{NaN, NaN, NaN}),
+			MakeData(1, []int64{1, 2, 3}, []float64{NaN, NaN, NaN}),
 		},
 		{
 			"left shift",
-			fields{1, []int64{1, 2, 3}, []DType{1, 2, 3}},
+			fields{1, []int64{1, 2, 3}, []float64{1, 2, 3}},
 			args{-1},
-			MakeData(1, []int64{1, 2, 3}, []DType{2, 3, NaN}),
+			MakeData(1, []int64{1, 2, 3}, []float64{2, 3, NaN}),
 		},
 		{
 			"left shift -2",
-			fields{1, []int64{1, 2, 3}, []DType{1, 2, 3}},
+			fields{1, []int64{1, 2, 3}, []float64{1, 2, 3}},
 			args{-2},
-			MakeData(1, []int64{1, 2, 3}, []DType{3, NaN, NaN}),
+			MakeData(1, []int64{1, 2, 3}, []float64{3, NaN, NaN}),
 		},
 		{
 			"left shift equal to data length",
-			fields{1, []int64{1, 2, 3}, []DType{1, 2, 3}},
+			fields{1, []int64{1, 2, 3}, []float64{1, 2, 3}},
 			args{-3},
-			MakeData(1, []int64{1, 2, 3}, []DType{NaN, NaN, NaN}),
+			MakeData(1, []int64{1, 2, 3}, []float64{NaN, NaN, NaN}),
 		},
 		{
 			"left shift overflow",
-			fields{1, []int64{1, 2, 3}, []DType{1, 2, 3}},
+			fields{1, []int64{1, 2, 3}, []float64{1, 2, 3}},
 			args{-4},
-			MakeData(1, []int64{1, 2, 3}, []DType{NaN, NaN, NaN}),
+			MakeData(1, []int64{1, 2, 3}, []float64{NaN, NaN, NaN}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -1393,7 +1698,7 @@ func TestData_Resize(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
 		newLen int
@@ -1402,42 +1707,42 @@ func TestData_Resize(t *testing.T) {
 		name   string
 		fields fields
 		args   args
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"len + 0",
-			fields{1, []int64{1, 2, 3}, []DType{1, 2, 3}},
+			fields{1, []int64{1, 2, 3}, []float64{1, 2, 3}},
 			args{3},
-			MakeData(1, []int64{1, 2, 3}, []DType{1, 2, 3}),
+			MakeData(1, []int64{1, 2, 3}, []float64{1, 2, 3}),
 		},
 		{
 			"len + 1",
-			fields{1, []int64{1, 2, 3}, []DType{1, 2, 3}},
+			fields{1, []int64{1, 2, 3}, []float64{1, 2, 3}},
 			args{4},
-			MakeData(1, []int64{1, 2, 3, math.MaxInt64}, []DType{1, 2, 3, NaN}),
+			MakeData(1, []int64{1, 2, 3, math.MaxInt64}, []float64{1, 2, 3, NaN}),
 		},
 		{
 			"len - 1",
-			fields{1, []int64{1, 2, 3}, []DType{1, 2, 3}},
+			fields{1, []int64{1, 2, 3}, []float64{1, 2, 3}},
 			args{2},
-			MakeData(1, []int64{1, 2}, []DType{1, 2}),
+			MakeData(1, []int64{1, 2}, []float64{1, 2}),
 		},
 		{
 			"newLen == 0",
-			fields{1, []int64{1, 2, 3}, []DType{1, 2, 3}},
+			fields{1, []int64{1, 2, 3}, []float64{1, 2, 3}},
 			args{0},
-			MakeData(1, []int64{}, []DType{}),
+			MakeData(1, []int64{}, []float64{}),
 		},
 		{
 			"oldLen == 0, newLen == 3",
-			fields{1, []int64{}, []DType{}},
+			fields{1, []int64{}, []float64{}},
 			args{3},
-			MakeData(1, []int64{math.MaxInt64, math.MaxInt64, math.MaxInt64}, []DType{NaN, NaN, NaN}),
+			MakeData(1, []int64{math.MaxInt64, math.MaxInt64, math.MaxInt64}, []float64{NaN, NaN, NaN}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -1453,11 +1758,11 @@ func TestData_Equal(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
-		r   Data
-		eps DType
+		r   Data[float64]
+		eps float64
 	}
 	tests := []struct {
 		name   string
@@ -1467,27 +1772,27 @@ func TestData_Equal(t *testing.T) {
 	}{
 		{
 			"NaNs vs NaNs",
-			fields{1, []int64{2, 3, 4, 5, 6, 7, 8}, []DType{2, NaN, 4, NaN, 6, NaN, 8}},
+			fields{1, []int64{2, 3, 4, 5, 6, 7, 8}, []float64{2, NaN, 4, NaN, 6, NaN, 8}},
 			args{
-				r:   MakeData(1, []int64{2, 3, 4, 5, 6, 7, 8}, []DType{2, NaN, 4, NaN, 6, NaN, 8}),
+				r:   MakeData(1, []int64{2, 3, 4, 5, 6, 7, 8}, []float64{2, NaN, 4, NaN, 6, NaN, 8}),
 				eps: EpsFp32,
 			},
 			true,
 		},
 		{
 			"zeros vs NaNs",
-			fields{1, []int64{2, 3, 4, 5, 6, 7, 8}, []DType{2, 0, 4, 0, 6, 0, 8}},
+			fields{1, []int64{2, 3, 4, 5, 6, 7, 8}, []float64{2, 0, 4, 0, 6, 0, 8}},
 			args{
-				r:   MakeData(1, []int64{2, 3, 4, 5, 6, 7, 8}, []DType{2, NaN, 4, NaN, 6, NaN, 8}),
+				r:   MakeData(1, []int64{2, 3, 4, 5, 6, 7, 8}, []float64{2, NaN, 4, NaN, 6, NaN, 8}),
 				eps: EpsFp32,
 			},
 			false,
 		},
 		{
 			"NaNs vs zeros",
-			fields{1, []int64{2, 3, 4, 5, 6, 7, 8}, []DType{2, NaN, 4, NaN, 6, NaN, 8}},
+			fields{1, []int64{2, 3, 4, 5, 6, 7, 8}, []float64{2, NaN, 4, NaN, 6, NaN, 8}},
 			args{
-				r:   MakeData(1, []int64{2, 3, 4, 5, 6, 7, 8}, []DType{2, 0, 4, 0, 6, 0, 8}),
+				r:   MakeData(1, []int64{2, 3, 4, 5, 6, 7, 8}, []float64{2, 0, 4, 0, 6, 0, 8}),
 				eps: EpsFp32,
 			},
 			false,
@@ -1495,7 +1800,7 @@ func TestData_Equal(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -1511,65 +1816,65 @@ func TestData_Lerp(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"simple 1 gap - 1",
-			fields{1, []int64{0, 1, 2, 3}, []DType{0, 1, NaN, 3}},
+			fields{1, []int64{0, 1, 2, 3}, []float64{0, 1, NaN, 3}},
 			MakeData(
 				1,
 				[]int64{0, 1, 2, 3},
-				[]DType{0, 1, 2, 3},
+				[]float64{0, 1, 2, 3},
 			),
 		},
 		{
 			"simple 1 gap - 2",
-			fields{3, []int64{0, 1, 2, 3}, []DType{2, 5, NaN, 11}},
+			fields{3, []int64{0, 1, 2, 3}, []float64{2, 5, NaN, 11}},
 			MakeData(
 				3,
 				[]int64{0, 1, 2, 3},
-				[]DType{2, 5, 8, 11},
+				[]float64{2, 5, 8, 11},
 			),
 		},
 		{
 			"simple 2 gaps row",
-			fields{3, []int64{0, 1, 2, 3, 4}, []DType{2, 5, NaN, NaN, 11}},
+			fields{3, []int64{0, 1, 2, 3, 4}, []float64{2, 5, NaN, NaN, 11}},
 			MakeData(
 				3,
 				[]int64{0, 1, 2, 3, 4},
-				[]DType{2, 5, 7, 9, 11},
+				[]float64{2, 5, 7, 9, 11},
 			),
 		},
 		{
 			"simple 2 gaps at end",
-			fields{3, []int64{0, 1, 2, 3}, []DType{2, 5, NaN, NaN}},
+			fields{3, []int64{0, 1, 2, 3}, []float64{2, 5, NaN, NaN}},
 			MakeData(
 				3,
 				[]int64{0, 1, 2, 3},
-				[]DType{2, 5, NaN, NaN},
+				[]float64{2, 5, NaN, NaN},
 			),
 		},
 		{
 			"simple 2 gaps at begin",
-			fields{3, []int64{0, 1, 2, 3}, []DType{NaN, NaN, 2, 5}},
+			fields{3, []int64{0, 1, 2, 3}, []float64{NaN, NaN, 2, 5}},
 			MakeData(
 				3,
 				[]int64{0, 1, 2, 3},
-				[]DType{NaN, NaN, 2, 5},
+				[]float64{NaN, NaN, 2, 5},
 			),
 		},
 		{
 			"complex - 1",
-			fields{3, []int64{-1, 0, 1, 2, 3, 4, 5}, []DType{NaN, 2, 5, NaN, NaN, 11, NaN}},
+			fields{3, []int64{-1, 0, 1, 2, 3, 4, 5}, []float64{NaN, 2, 5, NaN, NaN, 11, NaN}},
 			MakeData(
 				3,
 				[]int64{-1, 0, 1, 2, 3, 4, 5},
-				[]DType{NaN, 2, 5, 7, 9, 11, NaN},
+				[]float64{NaN, 2, 5, 7, 9, 11, NaN},
 			),
 		},
 		{
@@ -1577,36 +1882,36 @@ func TestData_Lerp(t *testing.T) {
 			fields{
 				3,
 				[]int64{-1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
-				[]DType{NaN, 2, 5, NaN, NaN, 11, NaN, 16, NaN, NaN, NaN},
+				[]float64{NaN, 2, 5, NaN, NaN, 11, NaN, 16, NaN, NaN, NaN},
 			},
 			MakeData(
 				3,
 				[]int64{-1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
-				[]DType{NaN, 2, 5, 7, 9, 11, 13.5, 16, NaN, NaN, NaN},
+				[]float64{NaN, 2, 5, 7, 9, 11, 13.5, 16, NaN, NaN, NaN},
 			),
 		},
 		{
 			"no gaps",
-			fields{1, []int64{0, 1, 2, 3, 4}, []DType{0, 1, 2, 3, 4}},
+			fields{1, []int64{0, 1, 2, 3, 4}, []float64{0, 1, 2, 3, 4}},
 			MakeData(
 				1,
 				[]int64{0, 1, 2, 3, 4},
-				[]DType{0, 1, 2, 3, 4},
+				[]float64{0, 1, 2, 3, 4},
 			),
 		},
 		{
 			"all nans",
-			fields{1, []int64{0, 1, 2, 3, 4}, []DType{NaN, NaN, NaN, NaN, NaN}},
+			fields{1, []int64{0, 1, 2, 3, 4}, []float64{NaN, NaN, NaN, NaN, NaN}},
 			MakeData(
 				1,
 				[]int64{0, 1, 2, 3, 4},
-				[]DType{NaN, NaN, NaN, NaN, NaN},
+				[]float64{NaN, NaN, NaN, NaN, NaN},
 			),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -1622,44 +1927,44 @@ func TestData_Cumsum(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"one",
-			fields{1, []int64{0}, []DType{5}},
+			fields{1, []int64{0}, []float64{5}},
 			MakeData(
 				1,
 				[]int64{0},
-				[]DType{5},
+				[]float64{5},
 			),
 		},
 		{
 			"simple",
-			fields{1, []int64{0, 1, 2, 3}, []DType{5, 10, 15, 20}},
+			fields{1, []int64{0, 1, 2, 3}, []float64{5, 10, 15, 20}},
 			MakeData(
 				1,
 				[]int64{0, 1, 2, 3},
-				[]DType{5, 15, 30, 50},
+				[]float64{5, 15, 30, 50},
 			),
 		},
 		{
 			"simple 1 gap - 1",
-			fields{1, []int64{0, 1, 2, 3}, []DType{0, 1, NaN, 3}},
+			fields{1, []int64{0, 1, 2, 3}, []float64{0, 1, NaN, 3}},
 			MakeData(
 				1,
 				[]int64{0, 1, 2, 3},
-				[]DType{0, 1, NaN, 4},
+				[]float64{0, 1, NaN, 4},
 			),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -1675,41 +1980,41 @@ func TestData_Dot(t *testing.T) {
 	type fields struct {
 		freq   int64
 		index  []int64
-		values []DType
+		values []float64
 	}
 	type args struct {
-		r Data
+		r Data[float64]
 	}
 	tests := []struct {
 		name   string
 		fields fields
 		args   args
-		want   DType
+		want   float64
 	}{
 		{
 			name: "simple",
 			fields: fields{
-				values: []DType{0, 1, 2, 3},
+				values: []float64{0, 1, 2, 3},
 			},
 			args: args{
-				r: MakeValues([]DType{-1, 2, -3, 4}),
+				r: MakeValues([]float64{-1, 2, -3, 4}),
 			},
 			want: 8,
 		},
 		{
 			name: "with n/a",
 			fields: fields{
-				values: []DType{0, NaN, 2, 3},
+				values: []float64{0, NaN, 2, 3},
 			},
 			args: args{
-				r: MakeValues([]DType{-1, 2, -3, 4}),
+				r: MakeValues([]float64{-1, 2, -3, 4}),
 			},
 			want: NaN,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := Data{
+			d := Data[float64]{
 				freq:   tt.fields.freq,
 				index:  tt.fields.index,
 				values: tt.fields.values,
@@ -1,6 +1,10 @@
 package series
 
-import "github.com/WinPooh32/series/math"
+import (
+	"time"
+
+	"github.com/WinPooh32/series/math"
+)
 
 type AlphaType int
 
@@ -15,47 +19,307 @@ const (
 	AlphaHalflife
 )
 
-type ExpWindow struct {
-	data     Data
+// ExpWindowOption configures exponential window.
+type ExpWindowOption func(opts *expWindowOptions)
+
+type expWindowOptions struct {
+	minPeriods int
+}
+
+// WithExpMinPeriods sets minimum count of observed values required for a value,
+// otherwise the value is NaN.
+func WithExpMinPeriods(n int) ExpWindowOption {
+	if n <= 0 {
+		panic("min periods must be greater than zero")
+	}
+	return func(opts *expWindowOptions) {
+		opts.minPeriods = n
+	}
+}
+
+func makeExpWindowOptions(opts []ExpWindowOption) expWindowOptions {
+	var o expWindowOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+type ExpWindow[T Float] struct {
+	data     Data[T]
 	atype    AlphaType
-	param    DType
+	param    T
 	adjust   bool
 	ignoreNA bool
+	// halflife is the time of decay of weights by half,
+	// weights decay by gaps between index values if it is set.
+	halflife time.Duration
+	opts     expWindowOptions
 }
 
-func (w ExpWindow) Mean() Data {
-	var alpha DType
+func (w ExpWindow[T]) Mean() Data[T] {
+	obs := w.observations(Data[T]{})
 
+	if w.halflife > 0 {
+		values := make([]T, len(obs))
+		w.moments(w.data.values, w.data.values, obs, func(i, nobs int, mean, _, _, _ T) {
+			if nobs < w.periods() {
+				values[i] = math.NaN[T]()
+				return
+			}
+			values[i] = mean
+		})
+		return w.output(values)
+	}
+
+	data := w.applyMean(w.data.Clone(), w.alpha())
+	if w.opts.minPeriods > 0 {
+		nobs := 0
+		for i := range obs {
+			if obs[i] {
+				nobs++
+			}
+			if nobs < w.opts.minPeriods {
+				data.values[i] = math.NaN[T]()
+			}
+		}
+	}
+	return data.maskedLike(w.data)
+}
+
+// alpha returns smoothing factor α of the window parameter.
+func (w ExpWindow[T]) alpha() T {
 	switch w.atype {
 	case Alpha:
 		if w.param <= 0 {
 			panic("alpha param must be > 0")
 		}
-		alpha = w.param
+		return w.param
 
 	case AlphaCom:
 		if w.param <= 0 {
 			panic("com param must be >= 0")
 		}
-		alpha = 1 / (1 + w.param)
+		return 1 / (1 + w.param)
 
 	case AlphaSpan:
 		if w.param < 1 {
 			panic("span param must be >= 1")
 		}
-		alpha = 2 / (w.param + 1)
+		return 2 / (w.param + 1)
 
 	case AlphaHalflife:
 		if w.param <= 0 {
 			panic("halflife param must be > 0")
 		}
-		alpha = 1 - math.Exp(-math.Ln2/w.param)
+		return 1 - math.Exp(-math.Ln2/w.param)
+
+	default:
+		panic("unknown alpha type")
+	}
+}
+
+// Var returns exponentially weighted variance like pandas ewm().var().
+// If bias is false, the variance is corrected by the effective count of weighted values,
+// so it is unbiased.
+func (w ExpWindow[T]) Var(bias bool) Data[T] {
+	obs := w.observations(Data[T]{})
+	return w.output(w.cov(w.data.values, w.data.values, obs, bias))
+}
+
+// Std returns exponentially weighted standard deviation like pandas ewm().std().
+// It is the square root of Var.
+func (w ExpWindow[T]) Std(bias bool) Data[T] {
+	obs := w.observations(Data[T]{})
+	values := w.cov(w.data.values, w.data.values, obs, bias)
+	for i, v := range values {
+		values[i] = math.Sqrt(v)
+	}
+	return w.output(values)
+}
+
+// Cov returns exponentially weighted covariance with other series like pandas ewm().cov(other).
+// Only pairs of valid values are observations.
+// If bias is false, the covariance is corrected by the effective count of weighted values.
+func (w ExpWindow[T]) Cov(other Data[T], bias bool) Data[T] {
+	obs := w.observations(other)
+	return w.output(w.cov(w.data.values, other.values, obs, bias))
+}
+
+// Corr returns exponentially weighted Pearson correlation with other series
+// like pandas ewm().corr(other). Only pairs of valid values are observations.
+func (w ExpWindow[T]) Corr(other Data[T]) Data[T] {
+	var (
+		x, y = w.data.values, other.values
+		obs  = w.observations(other)
+
+		cov  = w.cov(x, y, obs, true)
+		varx = w.cov(x, x, obs, true)
+		vary = w.cov(y, y, obs, true)
+	)
+
+	for i := range cov {
+		den := varx[i] * vary[i]
+		if den <= 0 {
+			cov[i] = math.NaN[T]()
+			continue
+		}
+		cov[i] /= math.Sqrt(den)
+	}
+
+	return w.output(cov)
+}
+
+// observations reports which values are observed.
+// If other is not empty, only pairs of valid values are observed.
+func (w ExpWindow[T]) observations(other Data[T]) []bool {
+	if other.values != nil && len(other.values) != len(w.data.values) {
+		panic("length of data and other must be equal")
+	}
+
+	obs := make([]bool, len(w.data.values))
+	for i := range obs {
+		obs[i] = !w.data.isNA(i) && (other.values == nil || !other.isNA(i))
+	}
+	return obs
+}
+
+// output makes series of window results.
+func (w ExpWindow[T]) output(values []T) Data[T] {
+	data := w.data.Clone()
+	copy(data.values, values)
+	return data.maskedLike(w.data)
+}
+
+// periods returns minimum count of observed values required for a value.
+func (w ExpWindow[T]) periods() int {
+	if w.opts.minPeriods > 1 {
+		return w.opts.minPeriods
+	}
+	return 1
+}
+
+// decays returns factors of decay of older weights at every step.
+// Weights of time-aware window decay by half per halflife of the gap between index values.
+func (w ExpWindow[T]) decays() []T {
+	var (
+		index   = w.data.index
+		decays  = make([]T, len(w.data.values))
+		factor  = 1 - w.alpha()
+		seconds = w.halflife.Seconds()
+	)
+
+	for i := range decays {
+		if w.halflife <= 0 || i == 0 {
+			decays[i] = factor
+			continue
+		}
+
+		gap := index[i] - index[i-1]
+		if gap < 0 {
+			panic("index must be sorted in ascending order")
+		}
+		decays[i] = math.Pow(factor, T(time.Duration(gap).Seconds()/seconds))
 	}
 
-	return w.applyMean(w.data.Clone(), alpha)
+	return decays
+}
+
+// cov computes exponentially weighted covariance of x and y observations
+// by the same algorithm as pandas.
+func (w ExpWindow[T]) cov(x, y []T, obs []bool, bias bool) []T {
+	out := make([]T, len(x))
+
+	w.moments(x, y, obs, func(i, nobs int, _, cov, sumWt, sumWt2 T) {
+		switch {
+		case nobs < w.periods():
+			out[i] = math.NaN[T]()
+		case bias:
+			out[i] = cov
+		default:
+			num := sumWt * sumWt
+			den := num - sumWt2
+			if den > 0 {
+				out[i] = num / den * cov
+			} else {
+				out[i] = math.NaN[T]()
+			}
+		}
+	})
+
+	return out
+}
+
+// moments computes exponentially weighted mean of x and covariance of x and y observations,
+// emit receives them with count of observations and sums of weights at every position.
+func (w ExpWindow[T]) moments(x, y []T, obs []bool, emit func(i, nobs int, meanX, cov, sumWt, sumWt2 T)) {
+	var (
+		alpha  = w.alpha()
+		decays = w.decays()
+		newWt  = alpha
+
+		started      bool
+		nobs         int
+		meanX, meanY T
+		cov          T
+
+		sumWt, sumWt2, oldWt T = 1, 1, 1
+	)
+
+	if w.adjust {
+		newWt = 1
+	}
+
+	for i := range x {
+		switch {
+		case started && (obs[i] || !w.ignoreNA):
+			// Weights of older observations decay on every step,
+			// missing values are skipped completely if ignoreNA.
+			decay := decays[i]
+			sumWt *= decay
+			sumWt2 *= decay * decay
+			oldWt *= decay
+
+			if !obs[i] {
+				break
+			}
+
+			oldMeanX, oldMeanY := meanX, meanY
+
+			// Avoid numerical errors on constant series.
+			if meanX != x[i] {
+				meanX = (oldWt*oldMeanX + newWt*x[i]) / (oldWt + newWt)
+			}
+			if meanY != y[i] {
+				meanY = (oldWt*oldMeanY + newWt*y[i]) / (oldWt + newWt)
+			}
+
+			cov = (oldWt*(cov+(oldMeanX-meanX)*(oldMeanY-meanY)) +
+				newWt*(x[i]-meanX)*(y[i]-meanY)) / (oldWt + newWt)
+
+			sumWt += newWt
+			sumWt2 += newWt * newWt
+			oldWt += newWt
+
+			if !w.adjust {
+				sumWt /= oldWt
+				sumWt2 /= oldWt * oldWt
+				oldWt = 1
+			}
+		case !started && obs[i]:
+			started = true
+			meanX, meanY = x[i], y[i]
+		}
+
+		if obs[i] {
+			nobs++
+		}
+
+		emit(i, nobs, meanX, cov, sumWt, sumWt2)
+	}
 }
 
-func (w ExpWindow) applyMean(data Data, alpha DType) Data {
+func (w ExpWindow[T]) applyMean(data Data[T], alpha T) Data[T] {
 	if w.adjust {
 		w.adjustedMean(data, alpha, w.ignoreNA)
 	} else {
@@ -64,11 +328,11 @@ func (w ExpWindow) applyMean(data Data, alpha DType) Data {
 	return data
 }
 
-func (ExpWindow) adjustedMean(data Data, alpha DType, ignoreNA bool) {
+func (ExpWindow[T]) adjustedMean(data Data[T], alpha T, ignoreNA bool) {
 	var (
-		values []DType = data.Values()
-		weight DType   = 1
-		last   DType   = 0
+		values []T = data.Values()
+		weight T   = 1
+		last   T   = 0
 	)
 
 	alpha = 1 - alpha
@@ -76,7 +340,7 @@ func (ExpWindow) adjustedMean(data Data, alpha DType, ignoreNA bool) {
 
 		w := alpha*weight + 1
 
-		if IsNA(x) {
+		if data.isNA(t) {
 			if ignoreNA {
 				weight = w
 			}
@@ -92,21 +356,21 @@ func (ExpWindow) adjustedMean(data Data, alpha DType, ignoreNA bool) {
 	}
 }
 
-func (ExpWindow) notadjustedMean(data Data, alpha DType, ignoreNA bool) {
+func (ExpWindow[T]) notadjustedMean(data Data[T], alpha T, ignoreNA bool) {
 	var (
 		count  int
-		values []DType = data.Values()
-		beta   DType   = 1 - alpha
-		last   DType   = values[0]
+		values []T = data.Values()
+		beta   T   = 1 - alpha
+		last   T   = values[0]
 	)
-	if IsNA(last) {
+	if data.isNA(0) {
 		last = 0
 		values[0] = last
 	}
 	for t := 1; t < len(values); t++ {
 		x := values[t]
 
-		if IsNA(x) {
+		if data.isNA(t) {
 			values[t] = last
 			continue
 		}
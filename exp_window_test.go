@@ -2,20 +2,23 @@ package series
 
 import (
 	"testing"
+	"time"
+
+	"github.com/WinPooh32/series/math"
 )
 
 func TestExpWindow_Mean(t *testing.T) {
 	type fields struct {
-		data     Data
+		data     Data[float64]
 		atype    AlphaType
-		param    DType
+		param    float64
 		adjust   bool
 		ignoreNA bool
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"simple",
@@ -23,7 +26,7 @@ func TestExpWindow_Mean(t *testing.T) {
 				data: MakeData(
 					1,
 					[]int64{1, 2, 3, 4, 5},
-					[]DType{0, 1, 2, NaN, 4},
+					[]float64{0, 1, 2, NaN, 4},
 				),
 				atype:    AlphaCom,
 				param:    0.5,
@@ -33,13 +36,13 @@ func TestExpWindow_Mean(t *testing.T) {
 			MakeData(
 				1,
 				[]int64{1, 2, 3, 4, 5},
-				[]DType{0, 0.6923077, 1.575, 1.575, 3.198347},
+				[]float64{0, 0.6923077, 1.575, 1.575, 3.198347},
 			),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			w := ExpWindow{
+			w := ExpWindow[float64]{
 				data:     tt.fields.data,
 				atype:    tt.fields.atype,
 				param:    tt.fields.param,
@@ -53,3 +56,193 @@ func TestExpWindow_Mean(t *testing.T) {
 		})
 	}
 }
+
+func TestExpWindow_Var(t *testing.T) {
+	data := MakeData(1, []int64{1, 2, 3, 4}, []float64{1, 2, 3, 4})
+	w := data.EWM(Alpha, 0.5, true, false)
+
+	tests := []struct {
+		name string
+		got  Data[float64]
+		want []float64
+	}{
+		{"var", w.Var(false), []float64{NaN, 0.5, 0.928571, 1.385714}},
+		{"var bias", w.Var(true), []float64{0, 2. / 9, 0.530612, 0.862222}},
+		{"std", w.Std(false), []float64{NaN, math.Sqrt(0.5), math.Sqrt(0.928571), math.Sqrt(1.385714)}},
+		{"cov self", w.Cov(data, false), []float64{NaN, 0.5, 0.928571, 1.385714}},
+		{"corr self", w.Corr(data), []float64{NaN, 1, 1, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := MakeData(1, data.index, tt.want)
+			if !tt.got.Equals(want, 1e-5) {
+				t.Errorf("ExpWindow = %v, want %v", tt.got, want)
+			}
+		})
+	}
+}
+
+// ewmCovReference computes exponentially weighted covariance at every position
+// from the explicit weights of observations.
+func ewmCovReference(x, y []float64, alpha float64, adjust, ignoreNA, bias bool) []float64 {
+	var (
+		out    = make([]float64, len(x))
+		ws     []float64
+		xs, ys []float64
+		age    int
+	)
+
+	for t := range x {
+		age++
+
+		if !math.IsNaN(x[t]) && !math.IsNaN(y[t]) {
+			// Older weights decay by steps since the last observation.
+			if ignoreNA {
+				age = 1
+			}
+			for i := range ws {
+				ws[i] *= math.Pow(1-alpha, float64(age))
+			}
+			age = 0
+
+			switch {
+			case len(ws) == 0 || adjust:
+				ws = append(ws, 1)
+			default:
+				ws = append(ws, alpha)
+			}
+			xs = append(xs, x[t])
+			ys = append(ys, y[t])
+
+			// Not adjusted weights are renormalized to 1 on every observation.
+			if !adjust {
+				var sum float64
+				for _, w := range ws {
+					sum += w
+				}
+				for i := range ws {
+					ws[i] /= sum
+				}
+			}
+		}
+
+		if len(ws) == 0 {
+			out[t] = NaN
+			continue
+		}
+
+		var sw, sw2, mx, my float64
+		for i := range ws {
+			sw += ws[i]
+			sw2 += ws[i] * ws[i]
+			mx += ws[i] * xs[i]
+			my += ws[i] * ys[i]
+		}
+		mx /= sw
+		my /= sw
+
+		var cov float64
+		for i := range ws {
+			cov += ws[i] * (xs[i] - mx) * (ys[i] - my)
+		}
+		cov /= sw
+
+		switch {
+		case bias:
+			out[t] = cov
+		case sw*sw-sw2 > 0:
+			out[t] = cov * sw * sw / (sw*sw - sw2)
+		default:
+			out[t] = NaN
+		}
+	}
+
+	return out
+}
+
+func TestExpWindow_Cov_Reference(t *testing.T) {
+	x := makeRollingData(60)
+	y := makeRollingData(65).Slice(5, 65).Clone()
+	y.index = x.index
+
+	for _, adjust := range []bool{true, false} {
+		for _, ignoreNA := range []bool{true, false} {
+			w := x.EWM(AlphaSpan, 5, adjust, ignoreNA)
+			alpha := 2. / 6
+
+			for _, bias := range []bool{true, false} {
+				want := MakeData(1, x.index, ewmCovReference(x.values, y.values, alpha, adjust, ignoreNA, bias))
+				if got := w.Cov(y, bias); !got.Equals(want, 1e-9) {
+					t.Errorf("adjust=%v ignoreNA=%v bias=%v: ExpWindow.Cov() = %v, want %v", adjust, ignoreNA, bias, got, want)
+				}
+
+				wantVar := MakeData(1, x.index, ewmCovReference(x.values, x.values, alpha, adjust, ignoreNA, bias))
+				if got := w.Var(bias); !got.Equals(wantVar, 1e-9) {
+					t.Errorf("adjust=%v ignoreNA=%v bias=%v: ExpWindow.Var() = %v, want %v", adjust, ignoreNA, bias, got, wantVar)
+				}
+			}
+		}
+	}
+}
+
+func TestExpWindow_Duration(t *testing.T) {
+	sec := int64(time.Second)
+	index := []int64{0, 1 * sec, 3 * sec, 4 * sec, 10 * sec}
+	data := MakeData(sec, index, []float64{0, 1, 2, NaN, 4})
+
+	tests := []struct {
+		name string
+		got  Data[float64]
+		want Data[float64]
+	}{
+		{
+			"mean",
+			data.EWMDuration(time.Second, false).Mean(),
+			MakeData(sec, index, []float64{0, 0.6666667, 1.6363636, 1.6363636, 3.9748792}),
+		},
+		{
+			"mean min periods",
+			data.EWMDuration(time.Second, false, WithExpMinPeriods(2)).Mean(),
+			MakeData(sec, index, []float64{NaN, 0.6666667, 1.6363636, 1.6363636, 3.9748792}),
+		},
+		{
+			"not duration mean min periods",
+			data.EWM(AlphaCom, 0.5, true, false, WithExpMinPeriods(3)).Mean(),
+			MakeData(sec, index, []float64{NaN, NaN, 1.575, 1.575, 3.198347}),
+		},
+		{
+			"var min periods",
+			data.EWM(AlphaCom, 0.5, true, false, WithExpMinPeriods(3)).Var(true),
+			MakeData(sec, index, []float64{NaN, NaN, 0.3905325, 0.3905325, 0.7316659}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equals(tt.want, 10e-4) {
+				t.Errorf("ExpWindow = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpWindow_Duration_Regular(t *testing.T) {
+	// Halflife of regular index is the count of steps.
+	x := makeRollingData(60)
+	y := makeRollingData(65).Slice(5, 65).Clone()
+	for i := range x.index {
+		x.index[i] *= int64(2 * time.Second)
+	}
+	y.index = x.index
+
+	for _, ignoreNA := range []bool{true, false} {
+		got := x.EWMDuration(6*time.Second, ignoreNA)
+		want := x.EWM(AlphaHalflife, 3, true, ignoreNA)
+
+		if g, w := got.Var(false), want.Var(false); !g.Equals(w, 1e-9) {
+			t.Errorf("ignoreNA=%v: ExpWindow.Var() = %v, want %v", ignoreNA, g, w)
+		}
+		if g, w := got.Corr(y), want.Corr(y); !g.Equals(w, 1e-9) {
+			t.Errorf("ignoreNA=%v: ExpWindow.Corr() = %v, want %v", ignoreNA, g, w)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package series
+
+// ExpandingWindow provides expanding window calculations,
+// the window of every value spans all values from the first one.
+// Aggregations are computed incrementally in a single pass.
+type ExpandingWindow[T Float] struct {
+	window Window[T]
+}
+
+// Expanding provides expanding window calculations.
+// Windows with less than minPeriods valid values are NaN.
+func (d Data[T]) Expanding(minPeriods int) ExpandingWindow[T] {
+	if minPeriods <= 0 {
+		panic("min periods must be greater than zero")
+	}
+
+	size := len(d.values)
+	if size == 0 {
+		size = 1
+	}
+
+	return ExpandingWindow[T]{
+		window: Window[T]{
+			len:  size,
+			data: d,
+			opts: windowOptions{minPeriods: minPeriods},
+		},
+	}
+}
+
+// Sum returns cumulative sum of valid values.
+func (w ExpandingWindow[T]) Sum() Data[T] {
+	return w.window.Sum()
+}
+
+// Mean returns cumulative mean of valid values.
+func (w ExpandingWindow[T]) Mean() Data[T] {
+	return w.window.Mean()
+}
+
+// Min returns cumulative minimum of valid values.
+func (w ExpandingWindow[T]) Min() Data[T] {
+	return w.window.Min()
+}
+
+// Max returns cumulative maximum of valid values.
+func (w ExpandingWindow[T]) Max() Data[T] {
+	return w.window.Max()
+}
+
+// Var returns cumulative variance of valid values.
+// Ddof - Delta Degrees of Freedom. The divisor used in calculations is N - ddof,
+// where N represents the number of valid values.
+func (w ExpandingWindow[T]) Var(ddof int) Data[T] {
+	return w.moment(momentVar, ddof)
+}
+
+// Std returns cumulative standard deviation of valid values.
+// Ddof - Delta Degrees of Freedom. The divisor used in calculations is N - ddof,
+// where N represents the number of valid values.
+func (w ExpandingWindow[T]) Std(ddof int) Data[T] {
+	return w.moment(momentStd, ddof)
+}
+
+// Skew returns cumulative unbiased skewness of valid values.
+func (w ExpandingWindow[T]) Skew() Data[T] {
+	return w.moment(momentSkew, 0)
+}
+
+// Kurt returns cumulative unbiased excess kurtosis of valid values.
+func (w ExpandingWindow[T]) Kurt() Data[T] {
+	return w.moment(momentKurt, 0)
+}
+
+// Median returns cumulative median of valid values in O(n log n) time.
+func (w ExpandingWindow[T]) Median() Data[T] {
+	return w.window.Median()
+}
+
+// Quantile returns cumulative q-th quantile of valid values in O(n log n) time.
+func (w ExpandingWindow[T]) Quantile(q T, interpolation QuantileInterpolation) Data[T] {
+	return w.window.Quantile(q, interpolation)
+}
+
+// Apply applies custom aggregation function to every window.
+// Every window is passed as is, so it costs O(n^2) time.
+func (w ExpandingWindow[T]) Apply(agg AggregateFunc[T]) Data[T] {
+	return w.window.Apply(agg)
+}
+
+func (w ExpandingWindow[T]) moment(stat momentStat, ddof int) Data[T] {
+	if ddof < 0 {
+		panic("ddof must be positive value!")
+	}
+	return w.window.roll(&rollingMoments[T]{stat: stat, ddof: ddof})
+}
@@ -0,0 +1,70 @@
+package series
+
+import (
+	"testing"
+
+	"github.com/WinPooh32/series/math"
+)
+
+func TestData_Expanding(t *testing.T) {
+	index := []int64{1, 2, 3, 4, 5}
+	data := MakeData(1, index, []float64{1, 2, NaN, 3, 4})
+
+	tests := []struct {
+		name string
+		fn   func(ExpandingWindow[float64]) Data[float64]
+		want []float64
+	}{
+		{"sum", ExpandingWindow[float64].Sum, []float64{NaN, 3, 3, 6, 10}},
+		{"mean", ExpandingWindow[float64].Mean, []float64{NaN, 1.5, 1.5, 2, 2.5}},
+		{"min", ExpandingWindow[float64].Min, []float64{NaN, 1, 1, 1, 1}},
+		{"max", ExpandingWindow[float64].Max, []float64{NaN, 2, 2, 3, 4}},
+		{"var", func(w ExpandingWindow[float64]) Data[float64] { return w.Var(1) }, []float64{NaN, 0.5, 0.5, 1, 5. / 3}},
+		{"std", func(w ExpandingWindow[float64]) Data[float64] { return w.Std(0) }, []float64{NaN, 0.5, 0.5, math.Sqrt(2. / 3), math.Sqrt(1.25)}},
+		{"skew", ExpandingWindow[float64].Skew, []float64{NaN, 0, 0, 0, 0}},
+		{"kurt", ExpandingWindow[float64].Kurt, []float64{NaN, NaN, NaN, NaN, -1.2}},
+		{"median", ExpandingWindow[float64].Median, []float64{NaN, 1.5, 1.5, 2, 2.5}},
+		{"quantile", func(w ExpandingWindow[float64]) Data[float64] { return w.Quantile(1, QuantileLinear) }, []float64{NaN, 2, 2, 3, 4}},
+		{"apply", func(w ExpandingWindow[float64]) Data[float64] { return w.Apply(Count[float64]) }, []float64{NaN, 2, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := MakeData(1, index, tt.want)
+			if got := tt.fn(data.Expanding(2)); !got.Equals(want, 1e-9) {
+				t.Errorf("ExpandingWindow = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestData_Expanding_Apply(t *testing.T) {
+	data := makeRollingData(200)
+	w := data.Expanding(1)
+
+	variance := func(d Data[float64]) float64 {
+		if Count(d) <= 1 {
+			return NaN
+		}
+		return Variance(d, Mean(d), 1)
+	}
+
+	tests := []struct {
+		name string
+		got  Data[float64]
+		want Data[float64]
+	}{
+		{"sum", w.Sum(), w.Apply(Sum[float64])},
+		{"mean", w.Mean(), w.Apply(Mean[float64])},
+		{"min", w.Min(), w.Apply(Min[float64])},
+		{"max", w.Max(), w.Apply(Max[float64])},
+		{"var", w.Var(1), w.Apply(variance)},
+		{"skew", w.Skew(), w.Apply(Skew[float64])},
+		{"kurt", w.Kurt(), w.Apply(Kurt[float64])},
+		{"median", w.Median(), w.Apply(func(d Data[float64]) float64 { return Quantile(d, 0.5, QuantileLinear) })},
+	}
+	for _, tt := range tests {
+		if !tt.got.Equals(tt.want, 1e-9) {
+			t.Errorf("ExpandingWindow.%s() = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+}
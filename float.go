@@ -2,11 +2,29 @@ package series
 
 import "github.com/WinPooh32/series/math"
 
-func fpEq(v1, v2, eps DType) bool {
+// Float is the constraint of series value types.
+type Float interface {
+	float32 | float64
+}
+
+const (
+	EpsFp32 = 1e-7
+	EpsFp64 = 1e-14
+)
+
+// Eps returns default epsilon of floating point comparison for T.
+func Eps[T Float]() T {
+	if math.Is32[T]() {
+		return EpsFp32
+	}
+	return EpsFp64
+}
+
+func fpEq[T Float](v1, v2, eps T) bool {
 	return math.Abs(v1-v2) < eps
 }
 
-func fpZero(v DType, eps DType) DType {
+func fpZero[T Float](v T, eps T) T {
 	switch {
 	case math.Abs(v) < eps:
 		return 0
@@ -15,6 +33,8 @@ func fpZero(v DType, eps DType) DType {
 	}
 }
 
-func IsNA(v DType) bool {
+// IsNA reports whether v is the n/a sentinel value: NaN or ±Inf.
+// Data with the validity bitmap doesn't use sentinels, see Data.IsNAAt.
+func IsNA[T Float](v T) bool {
 	return math.IsNaN(v) || math.IsInf(v, 0)
 }
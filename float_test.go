@@ -6,9 +6,9 @@ import (
 
 func Test_fpEq(t *testing.T) {
 	type args struct {
-		v1  DType
-		v2  DType
-		eps DType
+		v1  float64
+		v2  float64
+		eps float64
 	}
 	tests := []struct {
 		name string
@@ -63,12 +63,12 @@ func Test_fpEq(t *testing.T) {
 
 func Test_fpZero(t *testing.T) {
 	type args struct {
-		v DType
+		v float64
 	}
 	tests := []struct {
 		name string
 		args args
-		want DType
+		want float64
 	}{
 		{
 			name: "1",
@@ -0,0 +1,573 @@
+package series
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frame is the container of named value columns sharing one index.
+type Frame[T Float] struct {
+	freq    int64
+	index   []int64
+	names   []string
+	columns [][]T
+	// valid holds optional validity bitmaps of columns.
+	valid []Bitmap
+}
+
+// MakeFrame makes frame instance.
+// freq is the size of values sample.
+// Every column must have the same length as index.
+func MakeFrame[T Float](freq int64, index []int64, names []string, columns [][]T) Frame[T] {
+	if len(names) != len(columns) {
+		panic("length of names and columns must be equal")
+	}
+	for _, col := range columns {
+		if len(col) != len(index) {
+			panic("length of index and columns must be equal")
+		}
+	}
+	for i, name := range names {
+		for _, other := range names[:i] {
+			if name == other {
+				panic("column names must be unique")
+			}
+		}
+	}
+	return Frame[T]{
+		freq:    freq,
+		index:   index,
+		names:   names,
+		columns: columns,
+		valid:   make([]Bitmap, len(columns)),
+	}
+}
+
+// MakeFrameFromData makes frame of series data with the same index.
+// Index of the first series is used as frame index.
+// Validity bitmaps of series data are kept.
+func MakeFrameFromData[T Float](names []string, data ...Data[T]) Frame[T] {
+	if len(names) != len(data) {
+		panic("length of names and data must be equal")
+	}
+	if len(data) == 0 {
+		return MakeFrame[T](0, nil, names, nil)
+	}
+
+	first := data[0]
+	columns := make([][]T, len(data))
+
+	for i, d := range data {
+		if !d.IndexEquals(first) {
+			panic("index of series data must be equal")
+		}
+		columns[i] = d.values
+	}
+
+	frame := MakeFrame(first.freq, first.index, names, columns)
+	for i, d := range data {
+		frame.valid[i] = d.valid
+	}
+
+	return frame
+}
+
+// String converts frame columns to string.
+// Index values are rendered as time.Duration.
+func (f Frame[T]) String() string {
+	var sb strings.Builder
+
+	sb.WriteString("[\n")
+
+	for i, x := range f.index {
+		t := time.Duration(x)
+
+		sb.WriteString("    ")
+		sb.WriteString(t.String())
+		sb.WriteString(":")
+
+		for j, col := range f.columns {
+			sb.WriteString(" ")
+			sb.WriteString(f.names[j])
+			sb.WriteString("=")
+			if f.ColumnAt(j).isNA(i) && !f.valid[j].IsZero() {
+				sb.WriteString("NA")
+			} else {
+				sb.WriteString(strconv.FormatFloat(float64(col[i]), 'f', -1, 64))
+			}
+		}
+
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("]\n")
+
+	return sb.String()
+}
+
+// Index returns underlying index values.
+func (f Frame[T]) Index() (index []int64) {
+	return f.index
+}
+
+// Names returns column names.
+func (f Frame[T]) Names() (names []string) {
+	return f.names
+}
+
+// Freq returns period length of one sample.
+func (f Frame[T]) Freq() int64 {
+	return f.freq
+}
+
+// Len returns count of frame rows.
+func (f Frame[T]) Len() int {
+	return len(f.index)
+}
+
+// Width returns count of frame columns.
+func (f Frame[T]) Width() int {
+	return len(f.columns)
+}
+
+// Has reports whether the frame has column with the name.
+func (f Frame[T]) Has(name string) bool {
+	return f.lookup(name) >= 0
+}
+
+// Column returns column by name as series data.
+// Returned data shares memory with the frame.
+func (f Frame[T]) Column(name string) Data[T] {
+	i := f.lookup(name)
+	if i < 0 {
+		panic("column " + strconv.Quote(name) + " is not found")
+	}
+	return f.ColumnAt(i)
+}
+
+// ColumnAt returns column at i position as series data.
+// Returned data shares memory with the frame.
+func (f Frame[T]) ColumnAt(i int) Data[T] {
+	return Data[T]{
+		freq:   f.freq,
+		index:  f.index,
+		values: f.columns[i],
+		valid:  f.valid[i],
+	}
+}
+
+// Equals tests frames are equal to each other.
+// NaN values are considered to be equal.
+func (f Frame[T]) Equals(r Frame[T], eps T) bool {
+	if len(f.names) != len(r.names) {
+		return false
+	}
+	for i, name := range f.names {
+		j := r.lookup(name)
+		if j < 0 {
+			return false
+		}
+		if !f.ColumnAt(i).Equals(r.ColumnAt(j), eps) {
+			return false
+		}
+	}
+	return len(f.names) > 0 || f.placeholder().IndexEquals(r.placeholder())
+}
+
+// Slice makes slice of rows.
+// l and r can be negatvie values.
+func (f Frame[T]) Slice(l, r int) Frame[T] {
+	if l < 0 {
+		l = len(f.index) + l
+	}
+	if r < 0 {
+		r = (len(f.index) + r) + 1
+	}
+
+	columns := make([][]T, len(f.columns))
+	valid := make([]Bitmap, len(f.columns))
+	for i, col := range f.columns {
+		columns[i] = col[l:r]
+		valid[i] = f.valid[i].Slice(l, r)
+	}
+
+	return Frame[T]{
+		freq:    f.freq,
+		index:   f.index[l:r],
+		names:   f.names,
+		columns: columns,
+		valid:   valid,
+	}
+}
+
+// Clone makes full copy of the frame.
+func (f Frame[T]) Clone() Frame[T] {
+	columns := make([][]T, len(f.columns))
+	valid := make([]Bitmap, len(f.columns))
+	for i, col := range f.columns {
+		columns[i] = append([]T(nil), col...)
+		valid[i] = f.valid[i].Clone()
+	}
+
+	return Frame[T]{
+		freq:    f.freq,
+		index:   append([]int64(nil), f.index...),
+		names:   append([]string(nil), f.names...),
+		columns: columns,
+		valid:   valid,
+	}
+}
+
+// IndexSort sorts frame's rows by index.
+func (f Frame[T]) IndexSort() Frame[T] {
+	sort.Sort(frameArgSortable[T](f))
+	return f
+}
+
+// IndexSortStable sorts frame's rows by index using stable sort algorithm.
+func (f Frame[T]) IndexSortStable() Frame[T] {
+	sort.Stable(frameArgSortable[T](f))
+	return f
+}
+
+// Shrink removes rows which have n/a value at any column.
+//
+// New Frame instance will be returned.
+// Old and new have the same internal arrays. No additional memory is used.
+func (f Frame[T]) Shrink() Frame[T] {
+	n := 0
+
+	for i := range f.index {
+		if f.rowHasNA(i) {
+			continue
+		}
+		if n != i {
+			f.index[n] = f.index[i]
+			for _, col := range f.columns {
+				col[n] = col[i]
+			}
+		}
+		n++
+	}
+
+	f = f.Slice(0, n)
+	for _, valid := range f.valid {
+		valid.Fill(true)
+	}
+
+	return f
+}
+
+// Rolling provides rolling window calculations over every column.
+func (f Frame[T]) Rolling(window int, opts ...WindowOption) FrameWindow[T] {
+	return FrameWindow[T]{
+		window: Window[T]{len: window, opts: makeWindowOptions(opts)},
+		frame:  f,
+	}
+}
+
+// RollingDuration provides rolling window calculations within dur duration over every column.
+func (f Frame[T]) RollingDuration(dur time.Duration, opts ...WindowOption) FrameWindow[T] {
+	return FrameWindow[T]{
+		window: Data[T]{}.RollingDuration(dur, opts...),
+		frame:  f,
+	}
+}
+
+// EWM provides exponential weighted calculations over every column.
+func (f Frame[T]) EWM(atype AlphaType, param T, adjust bool, ignoreNA bool, opts ...ExpWindowOption) FrameExpWindow[T] {
+	return FrameExpWindow[T]{
+		window: Data[T]{}.EWM(atype, param, adjust, ignoreNA, opts...),
+		frame:  f,
+	}
+}
+
+// EWMDuration provides exponential weighted calculations with weights decaying by half per halflife
+// over every column.
+func (f Frame[T]) EWMDuration(halflife time.Duration, ignoreNA bool, opts ...ExpWindowOption) FrameExpWindow[T] {
+	return FrameExpWindow[T]{
+		window: Data[T]{}.EWMDuration(halflife, ignoreNA, opts...),
+		frame:  f,
+	}
+}
+
+// Resample provides resampling of every column.
+func (f Frame[T]) Resample(freq int64, origin ResampleOrigin, opts ...ResampleOption) FrameResampler[T] {
+	return FrameResampler[T]{
+		resampleRule: makeResampleRule(freq, origin, opts...),
+		frame:        f,
+	}
+}
+
+// ResampleCalendar provides resampling by calendar periods of every column.
+func (f Frame[T]) ResampleCalendar(freq CalendarFreq, origin ResampleOrigin, opts ...ResampleOption) FrameResampler[T] {
+	return FrameResampler[T]{
+		resampleRule: makeCalendarRule(freq, origin, opts...),
+		frame:        f,
+	}
+}
+
+func (f Frame[T]) lookup(name string) int {
+	for i, n := range f.names {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (f Frame[T]) rowHasNA(i int) bool {
+	for j := range f.columns {
+		if f.ColumnAt(j).isNA(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// placeholder returns zero filled series data with the frame's index.
+func (f Frame[T]) placeholder() Data[T] {
+	values := make([]T, len(f.index))
+	return MakeData(f.freq, f.index, values)
+}
+
+// mapColumns applies fn to every column and assembles new frame from the results.
+// Index of the new frame is taken from fn results.
+func (f Frame[T]) mapColumns(fn func(col Data[T]) Data[T]) Frame[T] {
+	if len(f.columns) == 0 {
+		res := fn(f.placeholder())
+		return Frame[T]{
+			freq:  res.freq,
+			index: res.index,
+			names: f.names,
+		}
+	}
+
+	var (
+		freq    int64
+		index   []int64
+		columns = make([][]T, len(f.columns))
+		valid   = make([]Bitmap, len(f.columns))
+	)
+
+	for i := range f.columns {
+		res := fn(f.ColumnAt(i))
+		if i == 0 {
+			freq = res.freq
+			index = res.index
+		}
+		columns[i] = res.values
+		valid[i] = res.valid
+	}
+
+	return Frame[T]{
+		freq:    freq,
+		index:   index,
+		names:   f.names,
+		columns: columns,
+		valid:   valid,
+	}
+}
+
+type frameArgSortable[T Float] Frame[T]
+
+func (x frameArgSortable[T]) Len() int { return len(x.index) }
+
+func (x frameArgSortable[T]) Less(i, j int) bool { return x.index[i] < x.index[j] }
+
+func (x frameArgSortable[T]) Swap(i, j int) {
+	x.index[i], x.index[j] = x.index[j], x.index[i]
+	for k, col := range x.columns {
+		col[i], col[j] = col[j], col[i]
+		if !x.valid[k].IsZero() {
+			x.valid[k].Swap(i, j)
+		}
+	}
+}
+
+// FrameWindow provides rolling window calculations over frame columns.
+type FrameWindow[T Float] struct {
+	window Window[T]
+	frame  Frame[T]
+}
+
+// of returns the window over col.
+func (w FrameWindow[T]) of(col Data[T]) Window[T] {
+	window := w.window
+	window.data = col
+	return window
+}
+
+func (w FrameWindow[T]) Sum() Frame[T] {
+	return w.frame.mapColumns(func(col Data[T]) Data[T] { return w.of(col).Sum() })
+}
+
+func (w FrameWindow[T]) Mean() Frame[T] {
+	return w.frame.mapColumns(func(col Data[T]) Data[T] { return w.of(col).Mean() })
+}
+
+func (w FrameWindow[T]) Min() Frame[T] {
+	return w.frame.mapColumns(func(col Data[T]) Data[T] { return w.of(col).Min() })
+}
+
+func (w FrameWindow[T]) Max() Frame[T] {
+	return w.frame.mapColumns(func(col Data[T]) Data[T] { return w.of(col).Max() })
+}
+
+func (w FrameWindow[T]) Median() Frame[T] {
+	return w.frame.mapColumns(func(col Data[T]) Data[T] { return w.of(col).Median() })
+}
+
+func (w FrameWindow[T]) Skew() Frame[T] {
+	return w.frame.mapColumns(func(col Data[T]) Data[T] { return w.of(col).Skew(col) })
+}
+
+// Var returns rolling variance of every column, see Window.Var.
+func (w FrameWindow[T]) Var(ddof int) Frame[T] {
+	return w.frame.mapColumns(func(col Data[T]) Data[T] { return w.of(col).Var(ddof) })
+}
+
+// StdDev returns rolling standard deviation of every column, see Window.StdDev.
+func (w FrameWindow[T]) StdDev(ddof int) Frame[T] {
+	return w.frame.mapColumns(func(col Data[T]) Data[T] { return w.of(col).StdDev(ddof) })
+}
+
+func (w FrameWindow[T]) Apply(agg AggregateFunc[T]) Frame[T] {
+	return w.frame.mapColumns(func(col Data[T]) Data[T] { return w.of(col).Apply(agg) })
+}
+
+// FrameExpWindow provides exponential weighted calculations over frame columns.
+type FrameExpWindow[T Float] struct {
+	window ExpWindow[T]
+	frame  Frame[T]
+}
+
+// of returns the exponential window over col.
+func (w FrameExpWindow[T]) of(col Data[T]) ExpWindow[T] {
+	ew := w.window
+	ew.data = col
+	return ew
+}
+
+func (w FrameExpWindow[T]) Mean() Frame[T] {
+	return w.frame.mapColumns(func(col Data[T]) Data[T] { return w.of(col).Mean() })
+}
+
+// Var returns exponentially weighted variance of every column, see ExpWindow.Var.
+func (w FrameExpWindow[T]) Var(bias bool) Frame[T] {
+	return w.frame.mapColumns(func(col Data[T]) Data[T] { return w.of(col).Var(bias) })
+}
+
+// Std returns exponentially weighted standard deviation of every column, see ExpWindow.Std.
+func (w FrameExpWindow[T]) Std(bias bool) Frame[T] {
+	return w.frame.mapColumns(func(col Data[T]) Data[T] { return w.of(col).Std(bias) })
+}
+
+// Cov returns exponentially weighted covariance of every column with other series, see ExpWindow.Cov.
+func (w FrameExpWindow[T]) Cov(other Data[T], bias bool) Frame[T] {
+	return w.frame.mapColumns(func(col Data[T]) Data[T] { return w.of(col).Cov(other, bias) })
+}
+
+// Corr returns exponentially weighted correlation of every column with other series, see ExpWindow.Corr.
+func (w FrameExpWindow[T]) Corr(other Data[T]) Frame[T] {
+	return w.frame.mapColumns(func(col Data[T]) Data[T] { return w.of(col).Corr(other) })
+}
+
+// FrameResampler resamples every column of the frame.
+type FrameResampler[T Float] struct {
+	resampleRule
+	frame Frame[T]
+}
+
+// Sum applies sum function to sample group.
+func (res FrameResampler[T]) Sum() Frame[T] {
+	return res.downsample(Resampler[T].Sum)
+}
+
+// Mean applies mean function to sample group.
+func (res FrameResampler[T]) Mean() Frame[T] {
+	return res.downsample(Resampler[T].Mean)
+}
+
+// Min applies min function to sample group.
+func (res FrameResampler[T]) Min() Frame[T] {
+	return res.downsample(Resampler[T].Min)
+}
+
+// Max applies max function to sample group.
+func (res FrameResampler[T]) Max() Frame[T] {
+	return res.downsample(Resampler[T].Max)
+}
+
+// Median applies median function to sample group.
+func (res FrameResampler[T]) Median() Frame[T] {
+	return res.downsample(Resampler[T].Median)
+}
+
+// First applies first function to sample group.
+func (res FrameResampler[T]) First() Frame[T] {
+	return res.downsample(Resampler[T].First)
+}
+
+// Last applies last function to sample group.
+func (res FrameResampler[T]) Last() Frame[T] {
+	return res.downsample(Resampler[T].Last)
+}
+
+// Count counts not NA values of sample group.
+func (res FrameResampler[T]) Count() Frame[T] {
+	return res.downsample(Resampler[T].Count)
+}
+
+// Prod applies product function to sample group.
+func (res FrameResampler[T]) Prod() Frame[T] {
+	return res.downsample(Resampler[T].Prod)
+}
+
+// NUnique counts distinct values of sample group.
+func (res FrameResampler[T]) NUnique() Frame[T] {
+	return res.downsample(Resampler[T].NUnique)
+}
+
+// Var applies variance function to sample group.
+func (res FrameResampler[T]) Var(ddof int) Frame[T] {
+	return res.downsample(func(r Resampler[T]) Data[T] { return r.Var(ddof) })
+}
+
+// Std applies standard deviation function to sample group.
+func (res FrameResampler[T]) Std(ddof int) Frame[T] {
+	return res.downsample(func(r Resampler[T]) Data[T] { return r.Std(ddof) })
+}
+
+// Sem applies standard error of the mean function to sample group.
+func (res FrameResampler[T]) Sem(ddof int) Frame[T] {
+	return res.downsample(func(r Resampler[T]) Data[T] { return r.Sem(ddof) })
+}
+
+// Quantile applies q-th quantile function to sample group.
+func (res FrameResampler[T]) Quantile(q T, interpolation QuantileInterpolation) Frame[T] {
+	return res.downsample(func(r Resampler[T]) Data[T] { return r.Quantile(q, interpolation) })
+}
+
+// Apply applies custom function to sample group.
+func (res FrameResampler[T]) Apply(agg AggregateFunc[T]) Frame[T] {
+	return res.downsample(func(r Resampler[T]) Data[T] { return r.Apply(agg) })
+}
+
+// Interpolate fills all NaNs between known values after applied upsamping.
+func (res FrameResampler[T]) Interpolate(method InterpolationMethod) Frame[T] {
+	return res.downsample(func(r Resampler[T]) Data[T] { return r.Interpolate(method) })
+}
+
+// InterpolateLimit fills NaNs restricted by limit after applied upsamping.
+func (res FrameResampler[T]) InterpolateLimit(method InterpolationMethod, limit FillLimit) Frame[T] {
+	return res.downsample(func(r Resampler[T]) Data[T] { return r.InterpolateLimit(method, limit) })
+}
+
+func (res FrameResampler[T]) downsample(fn func(Resampler[T]) Data[T]) Frame[T] {
+	return res.frame.mapColumns(func(col Data[T]) Data[T] {
+		return fn(Resampler[T]{resampleRule: res.resampleRule, data: col})
+	})
+}
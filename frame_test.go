@@ -0,0 +1,151 @@
+package series
+
+import (
+	"testing"
+)
+
+func TestFrame_IndexSort(t *testing.T) {
+	tests := []struct {
+		name  string
+		frame Frame[float64]
+		want  Frame[float64]
+	}{
+		{
+			"reversed",
+			MakeFrame(1, []int64{3, 2, 1}, []string{"a", "b"}, [][]float64{{3, 2, 1}, {30, 20, 10}}),
+			MakeFrame(1, []int64{1, 2, 3}, []string{"a", "b"}, [][]float64{{1, 2, 3}, {10, 20, 30}}),
+		},
+		{
+			"shuffled",
+			MakeFrame(1, []int64{2, 3, 1}, []string{"a", "b"}, [][]float64{{2, 3, 1}, {NaN, 30, 10}}),
+			MakeFrame(1, []int64{1, 2, 3}, []string{"a", "b"}, [][]float64{{1, 2, 3}, {10, NaN, 30}}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.frame.IndexSort(); !got.Equals(tt.want, EpsFp32) {
+				t.Errorf("Frame.IndexSort() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFrame_Shrink(t *testing.T) {
+	tests := []struct {
+		name  string
+		frame Frame[float64]
+		want  Frame[float64]
+	}{
+		{
+			"NaN at different columns",
+			MakeFrame(1, []int64{1, 2, 3, 4, 5}, []string{"a", "b"}, [][]float64{{1, NaN, 3, 4, 5}, {10, 20, 30, NaN, 50}}),
+			MakeFrame(1, []int64{1, 3, 5}, []string{"a", "b"}, [][]float64{{1, 3, 5}, {10, 30, 50}}),
+		},
+		{
+			"all NaN",
+			MakeFrame(1, []int64{1, 2}, []string{"a", "b"}, [][]float64{{1, NaN}, {NaN, 2}}),
+			MakeFrame(1, []int64{}, []string{"a", "b"}, [][]float64{{}, {}}),
+		},
+		{
+			"without NaN",
+			MakeFrame(1, []int64{1, 2}, []string{"a"}, [][]float64{{1, 2}}),
+			MakeFrame(1, []int64{1, 2}, []string{"a"}, [][]float64{{1, 2}}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.frame.Shrink(); !got.Equals(tt.want, EpsFp32) {
+				t.Errorf("Frame.Shrink() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFrame_Rolling(t *testing.T) {
+	frame := MakeFrame(1, []int64{1, 2, 3, 4, 5}, []string{"a", "b"}, [][]float64{{1, 2, 3, 4, 5}, {5, 4, 3, 2, 1}})
+	want := MakeFrame(1, []int64{1, 2, 3, 4, 5}, []string{"a", "b"}, [][]float64{{NaN, NaN, 6, 9, 12}, {NaN, NaN, 12, 9, 6}})
+
+	if got := frame.Rolling(3).Sum(); !got.Equals(want, EpsFp32) {
+		t.Errorf("FrameWindow.Sum() = %v, want %v", got, want)
+	}
+}
+
+func TestFrame_RollingDuration(t *testing.T) {
+	frame := MakeFrame(1, []int64{1, 2, 4, 8, 9}, []string{"a", "b"}, [][]float64{{1, 2, 3, 4, 5}, {5, 4, 3, 2, 1}})
+	want := MakeFrame(1, []int64{1, 2, 4, 8, 9}, []string{"a", "b"}, [][]float64{{1, 3, 5, 4, 9}, {5, 9, 7, 2, 3}})
+
+	if got := frame.RollingDuration(3).Sum(); !got.Equals(want, EpsFp32) {
+		t.Errorf("FrameWindow.Sum() = %v, want %v", got, want)
+	}
+}
+
+func TestFrame_Resample(t *testing.T) {
+	frame := MakeFrame(1, []int64{1, 2, 3, 4, 5, 6, 7}, []string{"a", "b"}, [][]float64{{1, 2, 3, 4, 5, 6, 7}, {7, 6, 5, 4, 3, 2, 1}})
+
+	tests := []struct {
+		name string
+		fn   func(FrameResampler[float64]) Frame[float64]
+		want Frame[float64]
+	}{
+		{
+			"sum",
+			FrameResampler[float64].Sum,
+			MakeFrame(2, []int64{1, 3, 5, 7}, []string{"a", "b"}, [][]float64{{3, 7, 11, 7}, {13, 9, 5, 1}}),
+		},
+		{
+			"max",
+			FrameResampler[float64].Max,
+			MakeFrame(2, []int64{1, 3, 5, 7}, []string{"a", "b"}, [][]float64{{2, 4, 6, 7}, {7, 5, 3, 1}}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fn(frame.Resample(2, OriginStart)); !got.Equals(tt.want, EpsFp32) {
+				t.Errorf("FrameResampler() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFrame_Column(t *testing.T) {
+	a := MakeData(1, []int64{1, 2, 3}, []float64{1, 2, 3})
+	b := MakeData(1, []int64{1, 2, 3}, []float64{4, 5, 6})
+
+	frame := MakeFrameFromData([]string{"a", "b"}, a, b)
+
+	if got := frame.Column("b"); !got.Equals(b, EpsFp32) {
+		t.Errorf("Frame.Column() = %v, want %v", got, b)
+	}
+	if frame.Has("c") {
+		t.Errorf("Frame.Has() = true, want false")
+	}
+}
+
+func TestFrame_EWM(t *testing.T) {
+	index := []int64{1, 2, 3, 4, 5}
+	frame := MakeFrame(1, index, []string{"a", "b"}, [][]float64{{1, 2, NaN, 4, 3}, {5, 3, 4, 1, 2}})
+	other := MakeData(1, index, []float64{2, 1, 3, 5, NaN})
+	w := frame.EWM(AlphaSpan, 3, true, false)
+
+	tests := []struct {
+		name string
+		got  Frame[float64]
+		fn   func(ExpWindow[float64]) Data[float64]
+	}{
+		{"mean", w.Mean(), ExpWindow[float64].Mean},
+		{"var", w.Var(false), func(ew ExpWindow[float64]) Data[float64] { return ew.Var(false) }},
+		{"std", w.Std(true), func(ew ExpWindow[float64]) Data[float64] { return ew.Std(true) }},
+		{"cov", w.Cov(other, false), func(ew ExpWindow[float64]) Data[float64] { return ew.Cov(other, false) }},
+		{"corr", w.Corr(other), func(ew ExpWindow[float64]) Data[float64] { return ew.Corr(other) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"a", "b"} {
+				want := tt.fn(frame.Column(name).EWM(AlphaSpan, 3, true, false))
+				if got := tt.got.Column(name); !got.Equals(want, EpsFp64) {
+					t.Errorf("FrameExpWindow column %s = %v, want %v", name, got, want)
+				}
+			}
+		})
+	}
+}
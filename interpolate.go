@@ -0,0 +1,449 @@
+package series
+
+import (
+	"github.com/WinPooh32/series/math"
+)
+
+// interpolationKindMask selects the method from InterpolationMethod,
+// the upper bits hold the polynomial order.
+const interpolationKindMask = 0xFF
+
+// InterpolationPolynomial fills NaNs by the polynomial of order degree
+// passing through order+1 known values nearest to the gap.
+// Distances are taken from the index.
+//
+// It is the local Lagrange interpolation, so knots far from the gap don't affect it.
+// Results differ from pandas method="polynomial", which fits the spline of order degree
+// through all known values by scipy.
+func InterpolationPolynomial(order int) InterpolationMethod {
+	if order <= 0 {
+		panic("polynomial order must be greater than zero")
+	}
+	return interpolationPolynomial | InterpolationMethod(order<<8)
+}
+
+func (m InterpolationMethod) kind() InterpolationMethod {
+	return m & interpolationKindMask
+}
+
+func (m InterpolationMethod) order() int {
+	return int(m >> 8)
+}
+
+// Interpolate fills NA values by the method.
+// Unknown methods leave values untouched.
+func (d Data[T]) Interpolate(method InterpolationMethod) Data[T] {
+	switch method.kind() {
+	case InterpolationLinear:
+		return d.Lerp()
+	case InterpolationPad:
+		return d.Pad()
+	case InterpolationNearest:
+		return d.Nearest()
+	case InterpolationBackfill:
+		return d.Backfill()
+	case InterpolationTime:
+		return d.LerpTime()
+	case InterpolationSpline:
+		return d.Spline()
+	case InterpolationAkima:
+		return d.Akima()
+	case interpolationPolynomial:
+		return d.Polynomial(method.order())
+	default:
+		return d
+	}
+}
+
+// Backfill fills NA values by the next known value.
+func (d Data[T]) Backfill() Data[T] {
+	var (
+		item  T
+		found bool
+		end   = len(d.values)
+	)
+
+	for i := len(d.values) - 1; i >= 0; i-- {
+		if d.isNA(i) {
+			continue
+		}
+		if found && i+1 < end {
+			d.fill(i+1, end, item)
+		}
+		item = d.values[i]
+		found = true
+		end = i
+	}
+
+	if found && end > 0 {
+		d.fill(0, end, item)
+	}
+
+	return d
+}
+
+// Nearest fills NA values between known values by the value nearest by index.
+// Ties are resolved in favor of the previous value.
+func (d Data[T]) Nearest() Data[T] {
+	k := d.knots()
+
+	return d.fillGaps(k, func(i, pos int, x float64) float64 {
+		if x-k.x[i] <= k.x[i+1]-x {
+			return k.y[i]
+		}
+		return k.y[i+1]
+	})
+}
+
+// LerpTime fills NA values between known values by linear interpolation
+// weighted by the index distance.
+func (d Data[T]) LerpTime() Data[T] {
+	k := d.knots()
+
+	return d.fillGaps(k, func(i, pos int, x float64) float64 {
+		return lerp(k.x[i], k.y[i], k.x[i+1], k.y[i+1], x)
+	})
+}
+
+// Spline fills NA values between known values by the natural cubic spline.
+// Distances are taken from the index.
+func (d Data[T]) Spline() Data[T] {
+	k := d.knots()
+	n := len(k.x)
+
+	if n < 3 {
+		return d.LerpTime()
+	}
+
+	// Second derivatives at knots, the natural spline has zero derivatives at the ends.
+	m := make([]float64, n)
+
+	// Solve tridiagonal system by the Thomas algorithm.
+	var (
+		c   = make([]float64, n)
+		rhs = make([]float64, n)
+	)
+	for i := 1; i < n-1; i++ {
+		h0 := k.x[i] - k.x[i-1]
+		h1 := k.x[i+1] - k.x[i]
+
+		a := h0 / 6
+		b := (h0 + h1) / 3
+		r := (k.y[i+1]-k.y[i])/h1 - (k.y[i]-k.y[i-1])/h0
+
+		den := b - a*c[i-1]
+		c[i] = (h1 / 6) / den
+		rhs[i] = (r - a*rhs[i-1]) / den
+	}
+	for i := n - 2; i > 0; i-- {
+		m[i] = rhs[i] - c[i]*m[i+1]
+	}
+
+	return d.fillGaps(k, func(i, pos int, x float64) float64 {
+		h := k.x[i+1] - k.x[i]
+		a := (k.x[i+1] - x) / h
+		b := (x - k.x[i]) / h
+		return a*k.y[i] + b*k.y[i+1] + ((a*a*a-a)*m[i]+(b*b*b-b)*m[i+1])*h*h/6
+	})
+}
+
+// Akima fills NA values between known values by the Akima spline.
+// Distances are taken from the index.
+func (d Data[T]) Akima() Data[T] {
+	k := d.knots()
+	n := len(k.x)
+
+	if n < 3 {
+		return d.LerpTime()
+	}
+
+	// Slopes of segments, two extra slopes are extrapolated at each end.
+	s := make([]float64, n+3)
+	for i := 0; i < n-1; i++ {
+		s[i+2] = (k.y[i+1] - k.y[i]) / (k.x[i+1] - k.x[i])
+	}
+	s[1] = 2*s[2] - s[3]
+	s[0] = 2*s[1] - s[2]
+	s[n+1] = 2*s[n] - s[n-1]
+	s[n+2] = 2*s[n+1] - s[n]
+
+	// Derivatives at knots.
+	t := make([]float64, n)
+	for i := range t {
+		w1 := math.Abs(s[i+3] - s[i+2])
+		w2 := math.Abs(s[i+1] - s[i])
+		if w1+w2 == 0 {
+			t[i] = (s[i+1] + s[i+2]) / 2
+		} else {
+			t[i] = (w1*s[i+1] + w2*s[i+2]) / (w1 + w2)
+		}
+	}
+
+	return d.fillGaps(k, func(i, pos int, x float64) float64 {
+		return hermite(k.x[i], k.y[i], t[i], k.x[i+1], k.y[i+1], t[i+1], x)
+	})
+}
+
+// Polynomial fills NA values between known values by the polynomial of order degree
+// passing through order+1 known values nearest to the gap, see InterpolationPolynomial.
+// Distances are taken from the index.
+func (d Data[T]) Polynomial(order int) Data[T] {
+	if order <= 0 {
+		panic("polynomial order must be greater than zero")
+	}
+
+	k := d.knots()
+
+	return d.fillGaps(k, func(i, pos int, x float64) float64 {
+		// Take knots around the gap, the left side gets the extra knot.
+		l := i - order/2
+		r := l + order + 1
+		if r > len(k.x) {
+			l -= r - len(k.x)
+			r = len(k.x)
+		}
+		if l < 0 {
+			r -= l
+			l = 0
+		}
+		if r > len(k.x) {
+			r = len(k.x)
+		}
+		return lagrange(k.x[l:r], k.y[l:r], x)
+	})
+}
+
+// knots are the known values of series data.
+// x holds index distances from the first known value.
+type knots struct {
+	pos  []int
+	x, y []float64
+}
+
+func (d Data[T]) knots() knots {
+	var k knots
+
+	for i, v := range d.values {
+		if d.isNA(i) {
+			continue
+		}
+		k.pos = append(k.pos, i)
+		k.x = append(k.x, float64(d.indexAt(i)-d.indexAt(k.pos[0])))
+		k.y = append(k.y, float64(v))
+	}
+
+	return k
+}
+
+// indexAt returns index value of the i-th value,
+// series without index are indexed by positions of values.
+func (d Data[T]) indexAt(i int) int64 {
+	if d.index == nil {
+		return int64(i)
+	}
+	return d.index[i]
+}
+
+// fillGaps fills NA values between known values by eval function.
+// eval accepts index of the left knot, offset of value and its index distance.
+func (d Data[T]) fillGaps(k knots, eval func(i, pos int, x float64) float64) Data[T] {
+	for i := 0; i+1 < len(k.pos); i++ {
+		for pos := k.pos[i] + 1; pos < k.pos[i+1]; pos++ {
+			x := float64(d.indexAt(pos) - d.indexAt(k.pos[0]))
+			d.values[pos] = T(eval(i, pos, x))
+			d.setValid(pos, true)
+		}
+	}
+	return d
+}
+
+func lerp(x0, y0, x1, y1, x float64) float64 {
+	if x1 == x0 {
+		return y0
+	}
+	return y0 + (y1-y0)*(x-x0)/(x1-x0)
+}
+
+// hermite evaluates cubic Hermite polynomial with values y and derivatives t at x0 and x1.
+func hermite(x0, y0, t0, x1, y1, t1, x float64) float64 {
+	h := x1 - x0
+	u := (x - x0) / h
+
+	h00 := (1 + 2*u) * (1 - u) * (1 - u)
+	h10 := u * (1 - u) * (1 - u)
+	h01 := u * u * (3 - 2*u)
+	h11 := u * u * (u - 1)
+
+	return h00*y0 + h10*h*t0 + h01*y1 + h11*h*t1
+}
+
+// lagrange evaluates polynomial passing through points at x.
+func lagrange(xs, ys []float64, x float64) float64 {
+	var sum float64
+	for i := range xs {
+		term := ys[i]
+		for j := range xs {
+			if i != j {
+				term *= (x - xs[j]) / (xs[i] - xs[j])
+			}
+		}
+		sum += term
+	}
+	return sum
+}
+
+// LimitDirection is the direction of counting consecutive NA values to fill.
+type LimitDirection int
+
+const (
+	// LimitForward fills NA values following known values.
+	LimitForward LimitDirection = iota
+	// LimitBackward fills NA values preceding known values.
+	LimitBackward
+	// LimitBoth fills NA values following and preceding known values.
+	LimitBoth
+)
+
+// LimitArea restricts which NA values are filled.
+type LimitArea int
+
+const (
+	// AreaAny fills all NA values.
+	AreaAny LimitArea = iota
+	// AreaInside fills NA values surrounded by known values only.
+	AreaInside
+	// AreaOutside fills leading and trailing NA values only.
+	AreaOutside
+)
+
+// FillLimit restricts filling of NA values.
+// Zero FillLimit doesn't restrict anything.
+type FillLimit struct {
+	// Limit is the maximum count of consecutive NA values to fill counted in Direction
+	// from known values, so forward limit never fills leading NA values.
+	// Zero means no limit.
+	Limit int
+	// Direction is the direction of counting Limit.
+	Direction LimitDirection
+	// Area restricts filling to inside or outside NA values.
+	Area LimitArea
+	// MaxGap is the maximum index distance between known values around the gap to fill.
+	// Leading and trailing gaps are measured from the known value to the farthest NA value.
+	// Zero means no limit.
+	MaxGap int64
+}
+
+// InterpolateLimit fills NA values by the method restricted by limit.
+// Values are computed as if there is no limit, restricted NA values stay untouched.
+func (d Data[T]) InterpolateLimit(method InterpolationMethod, limit FillLimit) Data[T] {
+	return d.fillLimited(limit, func(d Data[T]) Data[T] { return d.Interpolate(method) })
+}
+
+// FillnaLimit fills NA values by value restricted by limit.
+func (d Data[T]) FillnaLimit(value T, limit FillLimit) Data[T] {
+	return d.fillLimited(limit, func(d Data[T]) Data[T] { return d.Fillna(value) })
+}
+
+func (d Data[T]) fillLimited(limit FillLimit, fill func(d Data[T]) Data[T]) Data[T] {
+	if limit.Limit < 0 || limit.MaxGap < 0 {
+		panic("fill limits must be positive values")
+	}
+
+	keep := d.keepNA(limit)
+
+	saved := make([]T, 0, len(keep))
+	for _, i := range keep {
+		saved = append(saved, d.values[i])
+	}
+
+	d = fill(d)
+
+	for j, i := range keep {
+		d.values[i] = saved[j]
+		d.setValid(i, false)
+	}
+
+	return d
+}
+
+// keepNA returns offsets of NA values which must not be filled.
+func (d Data[T]) keepNA(limit FillLimit) []int {
+	var keep []int
+
+	n := len(d.values)
+
+	for beg := 0; beg < n; {
+		if !d.isNA(beg) {
+			beg++
+			continue
+		}
+
+		end := beg
+		for end < n && d.isNA(end) {
+			end++
+		}
+
+		leading, trailing := beg == 0, end == n
+		inside := !leading && !trailing
+
+		var gap int64
+		switch {
+		case inside:
+			gap = d.indexAt(end) - d.indexAt(beg-1)
+		case leading && !trailing:
+			gap = d.indexAt(end) - d.indexAt(beg)
+		case trailing && !leading:
+			gap = d.indexAt(end-1) - d.indexAt(beg-1)
+		}
+
+		for i := beg; i < end; i++ {
+			// NA values are counted from known values only.
+			fwd, bwd := i-beg, end-1-i
+			if leading {
+				fwd = n
+			}
+			if trailing {
+				bwd = n
+			}
+			if !limit.allows(fwd, bwd, inside, gap) {
+				keep = append(keep, i)
+			}
+		}
+
+		beg = end
+	}
+
+	return keep
+}
+
+// allows reports whether NA value can be filled.
+// fwd and bwd are counts of NA values between it and the known values before and after the gap.
+func (limit FillLimit) allows(fwd, bwd int, inside bool, gap int64) bool {
+	switch limit.Area {
+	case AreaInside:
+		if !inside {
+			return false
+		}
+	case AreaOutside:
+		if inside {
+			return false
+		}
+	}
+
+	if limit.MaxGap > 0 && gap > limit.MaxGap {
+		return false
+	}
+
+	if limit.Limit > 0 {
+		switch limit.Direction {
+		case LimitForward:
+			return fwd < limit.Limit
+		case LimitBackward:
+			return bwd < limit.Limit
+		case LimitBoth:
+			return fwd < limit.Limit || bwd < limit.Limit
+		}
+	}
+
+	return true
+}
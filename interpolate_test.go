@@ -0,0 +1,236 @@
+package series
+
+import (
+	"testing"
+)
+
+func TestData_Interpolate(t *testing.T) {
+	tests := []struct {
+		name   string
+		index  []int64
+		values []float64
+		method InterpolationMethod
+		want   []float64
+	}{
+		{
+			"backfill",
+			[]int64{1, 2, 3, 4, 5, 6},
+			[]float64{NaN, 1, NaN, NaN, 4, NaN},
+			InterpolationBackfill,
+			[]float64{1, 1, 4, 4, 4, NaN},
+		},
+		{
+			"nearest",
+			[]int64{0, 1, 2, 3, 4, 5},
+			[]float64{NaN, 0, NaN, NaN, NaN, 4},
+			InterpolationNearest,
+			[]float64{NaN, 0, 0, 0, 4, 4},
+		},
+		{
+			"time",
+			[]int64{0, 1, 4, 5, 6},
+			[]float64{0, NaN, NaN, 5, NaN},
+			InterpolationTime,
+			[]float64{0, 1, 4, 5, NaN},
+		},
+		{
+			"spline linear",
+			[]int64{0, 1, 3, 4, 7, 9},
+			[]float64{0, NaN, 6, NaN, 14, 18},
+			InterpolationSpline,
+			[]float64{0, 2, 6, 8, 14, 18},
+		},
+		{
+			"spline natural",
+			[]int64{0, 1, 2, 4},
+			[]float64{0, NaN, 2, 0},
+			InterpolationSpline,
+			[]float64{0, 1.375, 2, 0},
+		},
+		{
+			"akima linear",
+			[]int64{0, 1, 3, 4, 7, 9},
+			[]float64{0, NaN, 6, NaN, 14, 18},
+			InterpolationAkima,
+			[]float64{0, 2, 6, 8, 14, 18},
+		},
+		{
+			"akima plateau",
+			[]int64{0, 2, 3, 4, 6, 8, 10},
+			[]float64{0, 0, NaN, 0, 1, 1, 1},
+			InterpolationAkima,
+			[]float64{0, 0, 0, 0, 1, 1, 1},
+		},
+		{
+			"polynomial",
+			[]int64{0, 1, 2, 3, 4},
+			[]float64{0, 1, NaN, 9, 16},
+			InterpolationPolynomial(2),
+			[]float64{0, 1, 4, 9, 16},
+		},
+		{
+			// Only knots 1, 2 and 4 nearest to the gap are taken.
+			"polynomial local",
+			[]int64{0, 1, 2, 3, 4, 5, 6},
+			[]float64{0, 1, 8, NaN, 64, 125, 0},
+			InterpolationPolynomial(2),
+			[]float64{0, 1, 8, 29, 64, 125, 0},
+		},
+		{
+			"polynomial order exceeds knots",
+			[]int64{0, 1, 2},
+			[]float64{0, NaN, 2},
+			InterpolationPolynomial(3),
+			[]float64{0, 1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := MakeData(1, tt.index, tt.values)
+			want := MakeData(1, tt.index, tt.want)
+
+			if got := data.Interpolate(tt.method); !got.Equals(want, EpsFp64) {
+				t.Errorf("Data.Interpolate() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestData_Interpolate_Values(t *testing.T) {
+	// Values without index are interpolated by positions.
+	tests := []struct {
+		name   string
+		method InterpolationMethod
+		want   []float64
+	}{
+		{"nearest", InterpolationNearest, []float64{0, 0, 2, 2, 5, 5}},
+		{"time", InterpolationTime, []float64{0, 1, 2, 3, 4, 5}},
+		{"spline", InterpolationSpline, []float64{0, 1, 2, 3, 4, 5}},
+		{"akima", InterpolationAkima, []float64{0, 1, 2, 3, 4, 5}},
+		{"polynomial", InterpolationPolynomial(2), []float64{0, 1, 2, 3, 4, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := MakeValues([]float64{0, NaN, 2, NaN, NaN, 5})
+			want := MakeValues(tt.want)
+
+			if got := data.Interpolate(tt.method); !got.ValuesEquals(want, EpsFp64) {
+				t.Errorf("Data.Interpolate() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestData_Interpolate_Validity(t *testing.T) {
+	data := MakeDataValid(
+		1,
+		[]int64{0, 1, 2, 3},
+		[]float64{0, 42, 42, 3},
+		makeBitmap(true, false, false, true),
+	)
+
+	got := data.Spline()
+	want := MakeData(1, []int64{0, 1, 2, 3}, []float64{0, 1, 2, 3})
+
+	if got.HasNA() || !got.ValuesEquals(want, EpsFp64) {
+		t.Errorf("Data.Spline() = %v, want %v", got, want)
+	}
+}
+
+func TestData_InterpolateLimit(t *testing.T) {
+	index := []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
+	values := []float64{NaN, NaN, 2, NaN, NaN, NaN, 6, NaN, 8, NaN, NaN, NaN}
+
+	tests := []struct {
+		name  string
+		limit FillLimit
+		want  []float64
+	}{
+		{
+			"no limit",
+			FillLimit{},
+			[]float64{-1, -1, 2, -1, -1, -1, 6, -1, 8, -1, -1, -1},
+		},
+		{
+			"forward",
+			FillLimit{Limit: 1},
+			[]float64{NaN, NaN, 2, -1, NaN, NaN, 6, -1, 8, -1, NaN, NaN},
+		},
+		{
+			"backward",
+			FillLimit{Limit: 1, Direction: LimitBackward},
+			[]float64{NaN, -1, 2, NaN, NaN, -1, 6, -1, 8, NaN, NaN, NaN},
+		},
+		{
+			"both",
+			FillLimit{Limit: 1, Direction: LimitBoth},
+			[]float64{NaN, -1, 2, -1, NaN, -1, 6, -1, 8, -1, NaN, NaN},
+		},
+		{
+			"inside",
+			FillLimit{Area: AreaInside},
+			[]float64{NaN, NaN, 2, -1, -1, -1, 6, -1, 8, NaN, NaN, NaN},
+		},
+		{
+			"outside",
+			FillLimit{Area: AreaOutside},
+			[]float64{-1, -1, 2, NaN, NaN, NaN, 6, NaN, 8, -1, -1, -1},
+		},
+		{
+			"max gap",
+			FillLimit{MaxGap: 2},
+			[]float64{-1, -1, 2, NaN, NaN, NaN, 6, -1, 8, NaN, NaN, NaN},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := MakeData(1, index, append([]float64(nil), values...))
+			want := MakeData(1, index, tt.want)
+
+			if got := data.FillnaLimit(-1, tt.limit); !got.Equals(want, EpsFp64) {
+				t.Errorf("Data.FillnaLimit() = %v, want %v", got, want)
+			}
+		})
+	}
+
+	// Gaps of values without index are measured by positions.
+	got := MakeValues(append([]float64(nil), values...)).FillnaLimit(-1, FillLimit{MaxGap: 2})
+	if want := MakeValues([]float64{-1, -1, 2, NaN, NaN, NaN, 6, -1, 8, NaN, NaN, NaN}); !got.Equals(want, EpsFp64) {
+		t.Errorf("Data.FillnaLimit() = %v, want %v", got, want)
+	}
+
+	data := MakeData(1, index, append([]float64(nil), values...))
+	want := MakeData(1, index, []float64{NaN, NaN, 2, 3, NaN, 5, 6, 7, 8, NaN, NaN, NaN})
+
+	if got := data.InterpolateLimit(InterpolationLinear, FillLimit{Limit: 1, Direction: LimitBoth}); !got.Equals(want, EpsFp64) {
+		t.Errorf("Data.InterpolateLimit() = %v, want %v", got, want)
+	}
+}
+
+func TestData_FillnaLimit_Validity(t *testing.T) {
+	data := MakeDataValid(
+		1,
+		[]int64{1, 2, 3, 4, 5},
+		[]float64{42, 1, 42, 42, 5},
+		makeBitmap(false, true, false, false, true),
+	)
+	want := MakeDataValid(
+		1,
+		[]int64{1, 2, 3, 4, 5},
+		[]float64{42, 1, 0, 42, 5},
+		makeBitmap(false, true, true, false, true),
+	)
+
+	if got := data.FillnaLimit(0, FillLimit{Limit: 1, Area: AreaInside}); !got.Equals(want, EpsFp64) {
+		t.Errorf("Data.FillnaLimit() = %v, want %v", got, want)
+	}
+}
+
+func TestResampler_InterpolateLimit(t *testing.T) {
+	data := MakeData(2, []int64{0, 2, 8, 10}, []float64{0, 2, 8, 10})
+	want := MakeData(1, []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, []float64{0, 1, 2, NaN, NaN, NaN, NaN, NaN, 8, 9, 10})
+
+	if got := data.Resample(1, OriginStart).InterpolateLimit(InterpolationTime, FillLimit{MaxGap: 2}); !got.Equals(want, EpsFp64) {
+		t.Errorf("Resampler.InterpolateLimit() = %v, want %v", got, want)
+	}
+}
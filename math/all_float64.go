@@ -1,497 +0,0 @@
-//go:build !series_f32
-
-package math
-
-import (
-	m "math"
-)
-
-// Round returns the nearest integer, rounding half away from zero.
-//
-// Special cases are:
-//	Round(±0) = ±0
-//	Round(±Inf) = ±Inf
-//	Round(NaN) = NaN
-func Round(x float64) float64 { return m.Round(x) }
-
-// RoundToEven returns the nearest integer, rounding ties to even.
-//
-// Special cases are:
-//	RoundToEven(±0) = ±0
-//	RoundToEven(±Inf) = ±Inf
-//	RoundToEven(NaN) = NaN
-func RoundToEven(x float64) float64 { return m.RoundToEven(x) }
-
-// NaN returns an IEEE 754 ``not-a-number'' value.
-func NaN() float64 { return m.NaN() }
-
-// IsNaN reports whether f is an IEEE 754 ``not-a-number'' value.
-func IsNaN(x float64) bool { return m.IsNaN(x) }
-
-// IsInf reports whether f is an infinity, according to sign.
-// If sign > 0, IsInf reports whether f is positive infinity.
-// If sign < 0, IsInf reports whether f is negative infinity.
-// If sign == 0, IsInf reports whether f is either infinity.
-func IsInf(f float64, sign int) bool { return m.IsInf(f, sign) }
-
-// Sqrt returns the square root of x.
-//
-// Special cases are:
-//	Sqrt(+Inf) = +Inf
-//	Sqrt(±0) = ±0
-//	Sqrt(x < 0) = NaN
-//	Sqrt(NaN) = NaN
-func Sqrt(x float64) float64 { return m.Sqrt(x) }
-
-// Sin returns the sine of the radian argument x.
-//
-// Special cases are:
-//	Sin(±0) = ±0
-//	Sin(±Inf) = NaN
-//	Sin(NaN) = NaN
-func Sin(x float64) float64 { return float64(m.Sin(float64(x))) }
-
-// Cos returns the cosine of the radian argument x.
-//
-// Special cases are:
-//	Cos(±Inf) = NaN
-//	Cos(NaN) = NaN
-func Cos(x float64) float64 { return float64(m.Cos(float64(x))) }
-
-// Abs returns the absolute value of x.
-//
-// Special cases are:
-//	Abs(±Inf) = +Inf
-//	Abs(NaN) = NaN
-func Abs(x float64) float64 { return float64(m.Abs(float64(x))) }
-
-// Acos returns the arccosine, in radians, of x.
-//
-// Special case is:
-//	Acos(x) = NaN if x < -1 or x > 1
-func Acos(x float64) float64 { return float64(m.Acos(float64(x))) }
-
-// Asin returns the arcsine, in radians, of x.
-//
-// Special cases are:
-//	Asin(±0) = ±0
-//	Asin(x) = NaN if x < -1 or x > 1
-func Asin(x float64) float64 { return float64(m.Asin(float64(x))) }
-
-// Asinh returns the inverse hyperbolic sine of x.
-//
-// Special cases are:
-//	Asinh(±0) = ±0
-//	Asinh(±Inf) = ±Inf
-//	Asinh(NaN) = NaN
-func Asinh(x float64) float64 { return float64(m.Asinh(float64(x))) }
-
-// Atan returns the arctangent, in radians, of x.
-//
-// Special cases are:
-//      Atan(±0) = ±0
-//      Atan(±Inf) = ±Pi/2
-func Atan(x float64) float64 { return float64(m.Atan(float64(x))) }
-
-// Atan2 returns the arc tangent of y/x, using
-// the signs of the two to determine the quadrant
-// of the return value.
-//
-// Special cases are (in order):
-//	Atan2(y, NaN) = NaN
-//	Atan2(NaN, x) = NaN
-//	Atan2(+0, x>=0) = +0
-//	Atan2(-0, x>=0) = -0
-//	Atan2(+0, x<=-0) = +Pi
-//	Atan2(-0, x<=-0) = -Pi
-//	Atan2(y>0, 0) = +Pi/2
-//	Atan2(y<0, 0) = -Pi/2
-//	Atan2(+Inf, +Inf) = +Pi/4
-//	Atan2(-Inf, +Inf) = -Pi/4
-//	Atan2(+Inf, -Inf) = 3Pi/4
-//	Atan2(-Inf, -Inf) = -3Pi/4
-//	Atan2(y, +Inf) = 0
-//	Atan2(y>0, -Inf) = +Pi
-//	Atan2(y<0, -Inf) = -Pi
-//	Atan2(+Inf, x) = +Pi/2
-//	Atan2(-Inf, x) = -Pi/2
-func Atan2(y, x float64) float64 { return float64(m.Atan2(float64(y), float64(x))) }
-
-// Atanh returns the inverse hyperbolic tangent of x.
-//
-// Special cases are:
-//	Atanh(1) = +Inf
-//	Atanh(±0) = ±0
-//	Atanh(-1) = -Inf
-//	Atanh(x) = NaN if x < -1 or x > 1
-//	Atanh(NaN) = NaN
-func Atanh(x float64) float64 { return float64(m.Atanh(float64(x))) }
-
-// Cbrt returns the cube root of x.
-//
-// Special cases are:
-//	Cbrt(±0) = ±0
-//	Cbrt(±Inf) = ±Inf
-//	Cbrt(NaN) = NaN
-func Cbrt(x float64) float64 { return float64(m.Cbrt(float64(x))) }
-
-// Ceil returns the least integer value greater than or equal to x.
-//
-// Special cases are:
-//	Ceil(±0) = ±0
-//	Ceil(±Inf) = ±Inf
-//	Ceil(NaN) = NaN
-func Ceil(x float64) float64 { return float64(m.Ceil(float64(x))) }
-
-// Copysign returns a value with the magnitude
-// of x and the sign of y.
-func Copysign(x, y float64) float64 { return float64(m.Copysign(float64(x), float64(y))) }
-
-// Cosh returns the hyperbolic cosine of x.
-//
-// Special cases are:
-//	Cosh(±0) = 1
-//	Cosh(±Inf) = +Inf
-//	Cosh(NaN) = NaN
-//func Cosh(x float64) float64 { return float64(m.Cosh(float64(x))) }
-
-// Dim returns the maximum of x-y or 0.
-//
-// Special cases are:
-//	Dim(+Inf, +Inf) = NaN
-//	Dim(-Inf, -Inf) = NaN
-//	Dim(x, NaN) = Dim(NaN, x) = NaN
-func Dim(x, y float64) float64 { return float64(m.Dim(float64(x), float64(y))) }
-
-// Erf returns the error function of x.
-//
-// Special cases are:
-//	Erf(+Inf) = 1
-//	Erf(-Inf) = -1
-//	Erf(NaN) = NaN
-func Erf(x float64) float64 { return float64(m.Erf(float64(x))) }
-
-// Erfc returns the complementary error function of x.
-//
-// Special cases are:
-//	Erfc(+Inf) = 0
-//	Erfc(-Inf) = 2
-//	Erfc(NaN) = NaN
-func Erfc(x float64) float64 { return float64(m.Erfc(float64(x))) }
-
-// Exp returns e**x, the base-e exponential of x.
-//
-// Special cases are:
-//	Exp(+Inf) = +Inf
-//	Exp(NaN) = NaN
-// Very large values overflow to 0 or +Inf.
-// Very small values underflow to 1.
-func Exp(x float64) float64 { return float64(m.Exp(float64(x))) }
-
-// Exp2 returns 2**x, the base-2 exponential of x.
-//
-// Special cases are the same as Exp.
-func Exp2(x float64) float64 { return float64(m.Exp2(float64(x))) }
-
-// Expm1 returns e**x - 1, the base-e exponential of x minus 1.
-// It is more accurate than Exp(x) - 1 when x is near zero.
-//
-// Special cases are:
-//	Expm1(+Inf) = +Inf
-//	Expm1(-Inf) = -1
-//	Expm1(NaN) = NaN
-// Very large values overflow to -1 or +Inf.
-func Expm1(x float64) float64 { return float64(m.Expm1(float64(x))) }
-
-// Float32bits returns the IEEE 754 binary representation of f.
-func Float32bits(f float32) uint32 { return m.Float32bits(f) }
-
-// Float32frombits returns the floating point number corresponding
-// to the IEEE 754 binary representation b.
-func Float32frombits(b uint32) float32 { return m.Float32frombits(b) }
-
-// Float64bits returns the IEEE 754 binary representation of f.
-func Float64bits(f float64) uint64 { return m.Float64bits(f) }
-
-// Float64frombits returns the floating point number corresponding
-// the IEEE 754 binary representation b.
-func Float64frombits(b uint64) float64 { return m.Float64frombits(b) }
-
-// Floor returns the greatest integer value less than or equal to x.
-//
-// Special cases are:
-//	Floor(±0) = ±0
-//	Floor(±Inf) = ±Inf
-//	Floor(NaN) = NaN
-func Floor(x float64) float64 { return float64(m.Floor(float64(x))) }
-
-// Frexp breaks f into a normalized fraction
-// and an integral power of two.
-// It returns frac and exp satisfying f == frac × 2**exp,
-// with the absolute value of frac in the interval [½, 1).
-//
-// Special cases are:
-//	Frexp(±0) = ±0, 0
-//	Frexp(±Inf) = ±Inf, 0
-//	Frexp(NaN) = NaN, 0
-func Frexp(f float64) (frac float64, exp int) {
-	fr, exp := m.Frexp(float64(f))
-	return float64(fr), exp
-}
-
-// Gamma returns the Gamma function of x.
-//
-// Special cases are:
-//	Gamma(+Inf) = +Inf
-//	Gamma(+0) = +Inf
-//	Gamma(-0) = -Inf
-//	Gamma(x) = NaN for integer x < 0
-//	Gamma(-Inf) = NaN
-//	Gamma(NaN) = NaN
-func Gamma(x float64) float64 { return float64(m.Gamma(float64(x))) }
-
-// Hypot returns Sqrt(p*p + q*q), taking care to avoid
-// unnecessary overflow and underflow.
-//
-// Special cases are:
-//	Hypot(±Inf, q) = +Inf
-//	Hypot(p, ±Inf) = +Inf
-//	Hypot(NaN, q) = NaN
-//	Hypot(p, NaN) = NaN
-func Hypot(p, q float64) float64 { return float64(m.Hypot(float64(p), float64(q))) }
-
-// J0 returns the order-zero Bessel function of the first kind.
-//
-// Special cases are:
-//	J0(±Inf) = 0
-//	J0(0) = 1
-//	J0(NaN) = NaN
-func J0(x float64) float64 { return float64(m.J0(float64(x))) }
-
-// J1 returns the order-one Bessel function of the first kind.
-//
-// Special cases are:
-//	J1(±Inf) = 0
-//	J1(NaN) = NaN
-func J1(x float64) float64 { return float64(m.J1(float64(x))) }
-
-// Jn returns the order-n Bessel function of the first kind.
-//
-// Special cases are:
-//	Jn(n, ±Inf) = 0
-//	Jn(n, NaN) = NaN
-func Jn(n int, x float64) float64 { return float64(m.Jn(n, float64(x))) }
-
-// Ldexp is the inverse of Frexp.
-// It returns frac × 2**exp.
-//
-// Special cases are:
-//	Ldexp(±0, exp) = ±0
-//	Ldexp(±Inf, exp) = ±Inf
-//	Ldexp(NaN, exp) = NaN
-func Ldexp(frac float64, exp int) float64 { return float64(m.Ldexp(float64(frac), exp)) }
-
-// Lgamma returns the natural logarithm and sign (-1 or +1) of Gamma(x).
-//
-// Special cases are:
-//	Lgamma(+Inf) = +Inf
-//	Lgamma(0) = +Inf
-//	Lgamma(-integer) = +Inf
-//	Lgamma(-Inf) = -Inf
-//	Lgamma(NaN) = NaN
-func Lgamma(x float64) (lgamma float64, sign int) {
-	l, sign := m.Lgamma(float64(x))
-	return float64(l), sign
-}
-
-// Log returns the natural logarithm of x.
-//
-// Special cases are:
-//	Log(+Inf) = +Inf
-//	Log(0) = -Inf
-//	Log(x < 0) = NaN
-//	Log(NaN) = NaN
-func Log(x float64) float64 { return float64(m.Log(float64(x))) }
-
-// Log10 returns the decimal logarithm of x.
-// The special cases are the same as for Log.
-func Log10(x float64) float64 { return float64(m.Log10(float64(x))) }
-
-// Log1p returns the natural logarithm of 1 plus its argument x.
-// It is more accurate than Log(1 + x) when x is near zero.
-//
-// Special cases are:
-//	Log1p(+Inf) = +Inf
-//	Log1p(±0) = ±0
-//	Log1p(-1) = -Inf
-//	Log1p(x < -1) = NaN
-//	Log1p(NaN) = NaN
-func Log1p(x float64) float64 { return float64(m.Log1p(float64(x))) }
-
-// Log2 returns the binary logarithm of x.
-// The special cases are the same as for Log.
-func Log2(x float64) float64 { return float64(m.Log2(float64(x))) }
-
-// Max returns the larger of x or y.
-//
-// Special cases are:
-//	Max(x, +Inf) = Max(+Inf, x) = +Inf
-//	Max(x, NaN) = Max(NaN, x) = NaN
-//	Max(+0, ±0) = Max(±0, +0) = +0
-//	Max(-0, -0) = -0
-func Max(x, y float64) float64 { return float64(m.Max(float64(x), float64(y))) }
-
-// Min returns the smaller of x or y.
-//
-// Special cases are:
-//	Min(x, -Inf) = Min(-Inf, x) = -Inf
-//	Min(x, NaN) = Min(NaN, x) = NaN
-//	Min(-0, ±0) = Min(±0, -0) = -0
-func Min(x, y float64) float64 { return float64(m.Min(float64(x), float64(y))) }
-
-// Mod returns the floating-point remainder of x/y.
-// The magnitude of the result is less than y and its
-// sign agrees with that of x.
-//
-// Special cases are:
-//	Mod(±Inf, y) = NaN
-//	Mod(NaN, y) = NaN
-//	Mod(x, 0) = NaN
-//	Mod(x, ±Inf) = x
-//	Mod(x, NaN) = NaN
-func Mod(x, y float64) float64 { return float64(m.Mod(float64(x), float64(y))) }
-
-// Modf returns integer and fractional floating-point numbers
-// that sum to f.  Both values have the same sign as f.
-//
-// Special cases are:
-//	Modf(±Inf) = ±Inf, NaN
-//	Modf(NaN) = NaN, NaN
-func Modf(f float64) (int float64, frac float64) {
-	i, fr := m.Modf(float64(f))
-	return float64(i), float64(fr)
-}
-
-// Nextafter64 returns the next representable float64 value after x towards y.
-//
-// Special cases are:
-//	Nextafter(x, x)   = x
-//	Nextafter(NaN, y) = NaN
-//	Nextafter(x, NaN) = NaN
-func Nextafter(x, y float64) (r float64) { return m.Nextafter(x, y) }
-
-// Pow returns x**y, the base-x exponential of y.
-//
-// Special cases are (in order):
-//	Pow(x, ±0) = 1 for any x
-//	Pow(1, y) = 1 for any y
-//	Pow(x, 1) = x for any x
-//	Pow(NaN, y) = NaN
-//	Pow(x, NaN) = NaN
-//	Pow(±0, y) = ±Inf for y an odd integer < 0
-//	Pow(±0, -Inf) = +Inf
-//	Pow(±0, +Inf) = +0
-//	Pow(±0, y) = +Inf for finite y < 0 and not an odd integer
-//	Pow(±0, y) = ±0 for y an odd integer > 0
-//	Pow(±0, y) = +0 for finite y > 0 and not an odd integer
-//	Pow(-1, ±Inf) = 1
-//	Pow(x, +Inf) = +Inf for |x| > 1
-//	Pow(x, -Inf) = +0 for |x| > 1
-//	Pow(x, +Inf) = +0 for |x| < 1
-//	Pow(x, -Inf) = +Inf for |x| < 1
-//	Pow(+Inf, y) = +Inf for y > 0
-//	Pow(+Inf, y) = +0 for y < 0
-//	Pow(-Inf, y) = Pow(-0, -y)
-//	Pow(x, y) = NaN for finite x < 0 and finite non-integer y
-func Pow(x, y float64) float64 { return float64(m.Pow(float64(x), float64(y))) }
-
-// Pow10 returns 10**e, the base-10 exponential of e.
-//
-// Special cases are:
-//	Pow10(e) = +Inf for e > 309
-//	Pow10(e) = 0 for e < -324
-func Pow10(e int) float64 { return float64(m.Pow10(e)) }
-
-// Remainder returns the IEEE 754 floating-point remainder of x/y.
-//
-// Special cases are:
-//	Remainder(±Inf, y) = NaN
-//	Remainder(NaN, y) = NaN
-//	Remainder(x, 0) = NaN
-//	Remainder(x, ±Inf) = x
-//	Remainder(x, NaN) = NaN
-func Remainder(x, y float64) float64 { return float64(m.Remainder(float64(x), float64(y))) }
-
-// Signbit returns true if x is negative or negative zero.
-func Signbit(x float64) bool { return m.Signbit(float64(x)) }
-
-// Sincos returns Sin(x), Cos(x).
-//
-// Special cases are:
-//	Sincos(±0) = ±0, 1
-//	Sincos(±Inf) = NaN, NaN
-//	Sincos(NaN) = NaN, NaN
-func Sincos(x float64) (sin, cos float64) {
-	s, c := m.Sincos(float64(x))
-	return float64(s), float64(c)
-}
-
-// Sinh returns the hyperbolic sine of x.
-//
-// Special cases are:
-//	Sinh(±0) = ±0
-//	Sinh(±Inf) = ±Inf
-//	Sinh(NaN) = NaN
-func Sinh(x float64) float64 { return float64(m.Sinh(float64(x))) }
-
-// Tan returns the tangent of the radian argument x.
-//
-// Special cases are:
-//	Tan(±0) = ±0
-//	Tan(±Inf) = NaN
-//	Tan(NaN) = NaN
-func Tan(x float64) float64 { return float64(m.Tan(float64(x))) }
-
-// Tanh returns the hyperbolic tangent of x.
-//
-// Special cases are:
-//	Tanh(±0) = ±0
-//	Tanh(±Inf) = ±1
-//	Tanh(NaN) = NaN
-func Tanh(x float64) float64 { return float64(m.Tanh(float64(x))) }
-
-// Trunc returns the integer value of x.
-//
-// Special cases are:
-//	Trunc(±0) = ±0
-//	Trunc(±Inf) = ±Inf
-//	Trunc(NaN) = NaN
-func Trunc(x float64) float64 { return float64(m.Trunc(float64(x))) }
-
-// Y0 returns the order-zero Bessel function of the second kind.
-//
-// Special cases are:
-//	Y0(+Inf) = 0
-//	Y0(0) = -Inf
-//	Y0(x < 0) = NaN
-//	Y0(NaN) = NaN
-func Y0(x float64) float64 { return float64(m.Y0(float64(x))) }
-
-// Y1 returns the order-one Bessel function of the second kind.
-//
-// Special cases are:
-//	Y1(+Inf) = 0
-//	Y1(0) = -Inf
-//	Y1(x < 0) = NaN
-//	Y1(NaN) = NaN
-func Y1(x float64) float64 { return float64(m.Y1(float64(x))) }
-
-// Yn returns the order-n Bessel function of the second kind.
-//
-// Special cases are:
-//	Yn(n, +Inf) = 0
-//	Yn(n > 0, 0) = -Inf
-//	Yn(n < 0, 0) = +Inf if n is odd, -Inf if n is even
-//	Y1(n, x < 0) = NaN
-//	Y1(n, NaN) = NaN
-func Yn(n int, x float64) float64 { return float64(m.Yn(n, float64(x))) }
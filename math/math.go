@@ -1,105 +1,202 @@
-//go:build series_f32
-
 package math
 
 import (
-	stdm "math"
+	m "math"
 
-	m "github.com/WinPooh32/math"
+	m32 "github.com/WinPooh32/math"
 )
 
+// Float is the constraint of floating-point types supported by the package.
+type Float interface {
+	float32 | float64
+}
+
+// Is32 reports whether T is float32.
+func Is32[T Float]() bool {
+	var x T
+	_, ok := any(x).(float32)
+	return ok
+}
+
 // Round returns the nearest integer, rounding half away from zero.
 //
 // Special cases are:
+//
 //	Round(±0) = ±0
 //	Round(±Inf) = ±Inf
 //	Round(NaN) = NaN
-func Round(x float32) float32 { return float32(stdm.Round(float64(x))) }
+func Round[T Float](x T) T {
+	return T(m.Round(float64(x)))
+}
 
 // RoundToEven returns the nearest integer, rounding ties to even.
 //
 // Special cases are:
+//
 //	RoundToEven(±0) = ±0
 //	RoundToEven(±Inf) = ±Inf
 //	RoundToEven(NaN) = NaN
-func RoundToEven(x float32) float32 { return float32(stdm.RoundToEven(float64(x))) }
+func RoundToEven[T Float](x T) T {
+	return T(m.RoundToEven(float64(x)))
+}
+
+// NaN returns an IEEE 754 “not-a-number” value.
+func NaN[T Float]() T {
+	if Is32[T]() {
+		return T(m32.NaN())
+	}
+	return T(m.NaN())
+}
 
-// NaN returns an IEEE 754 ``not-a-number'' value.
-func NaN() float32 { return m.NaN() }
+// Inf returns positive infinity if sign >= 0, negative infinity if sign < 0.
+func Inf[T Float](sign int) T {
+	if Is32[T]() {
+		return T(m32.Inf(sign))
+	}
+	return T(m.Inf(sign))
+}
 
-// IsNaN reports whether f is an IEEE 754 ``not-a-number'' value.
-func IsNaN(x float32) bool { return m.IsNaN(x) }
+// MaxFloat returns the largest finite value representable by T.
+func MaxFloat[T Float]() T {
+	if Is32[T]() {
+		return T(float32(MaxFloat32))
+	}
+	var max float64 = MaxFloat64
+	return T(max)
+}
+
+// IsNaN reports whether f is an IEEE 754 “not-a-number” value.
+func IsNaN[T Float](x T) bool {
+	if Is32[T]() {
+		return m32.IsNaN(float32(x))
+	}
+	return m.IsNaN(float64(x))
+}
 
 // IsInf reports whether f is an infinity, according to sign.
 // If sign > 0, IsInf reports whether f is positive infinity.
 // If sign < 0, IsInf reports whether f is negative infinity.
 // If sign == 0, IsInf reports whether f is either infinity.
-func IsInf(f float32, sign int) bool { return m.IsInf(f, sign) }
+func IsInf[T Float](f T, sign int) bool {
+	if Is32[T]() {
+		return m32.IsInf(float32(f), sign)
+	}
+	return m.IsInf(float64(f), sign)
+}
 
 // Sqrt returns the square root of x.
 //
 // Special cases are:
+//
 //	Sqrt(+Inf) = +Inf
 //	Sqrt(±0) = ±0
 //	Sqrt(x < 0) = NaN
 //	Sqrt(NaN) = NaN
-func Sqrt(x float32) float32 { return m.Sqrt(x) }
+func Sqrt[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Sqrt(float32(x)))
+	}
+	return T(m.Sqrt(float64(x)))
+}
 
 // Sin returns the sine of the radian argument x.
 //
 // Special cases are:
+//
 //	Sin(±0) = ±0
 //	Sin(±Inf) = NaN
 //	Sin(NaN) = NaN
-func Sin(x float32) float32 { return float32(m.Sin(float32(x))) }
+func Sin[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Sin(float32(x)))
+	}
+	return T(m.Sin(float64(x)))
+}
 
 // Cos returns the cosine of the radian argument x.
 //
 // Special cases are:
+//
 //	Cos(±Inf) = NaN
 //	Cos(NaN) = NaN
-func Cos(x float32) float32 { return float32(m.Cos(float32(x))) }
+func Cos[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Cos(float32(x)))
+	}
+	return T(m.Cos(float64(x)))
+}
 
 // Abs returns the absolute value of x.
 //
 // Special cases are:
+//
 //	Abs(±Inf) = +Inf
 //	Abs(NaN) = NaN
-func Abs(x float32) float32 { return float32(m.Abs(float32(x))) }
+func Abs[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Abs(float32(x)))
+	}
+	return T(m.Abs(float64(x)))
+}
 
 // Acos returns the arccosine, in radians, of x.
 //
 // Special case is:
+//
 //	Acos(x) = NaN if x < -1 or x > 1
-func Acos(x float32) float32 { return float32(m.Acos(float32(x))) }
+func Acos[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Acos(float32(x)))
+	}
+	return T(m.Acos(float64(x)))
+}
 
 // Asin returns the arcsine, in radians, of x.
 //
 // Special cases are:
+//
 //	Asin(±0) = ±0
 //	Asin(x) = NaN if x < -1 or x > 1
-func Asin(x float32) float32 { return float32(m.Asin(float32(x))) }
+func Asin[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Asin(float32(x)))
+	}
+	return T(m.Asin(float64(x)))
+}
 
 // Asinh returns the inverse hyperbolic sine of x.
 //
 // Special cases are:
+//
 //	Asinh(±0) = ±0
 //	Asinh(±Inf) = ±Inf
 //	Asinh(NaN) = NaN
-func Asinh(x float32) float32 { return float32(m.Asinh(float32(x))) }
+func Asinh[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Asinh(float32(x)))
+	}
+	return T(m.Asinh(float64(x)))
+}
 
 // Atan returns the arctangent, in radians, of x.
 //
 // Special cases are:
-//      Atan(±0) = ±0
-//      Atan(±Inf) = ±Pi/2
-func Atan(x float32) float32 { return float32(m.Atan(float32(x))) }
+//
+//	Atan(±0) = ±0
+//	Atan(±Inf) = ±Pi/2
+func Atan[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Atan(float32(x)))
+	}
+	return T(m.Atan(float64(x)))
+}
 
 // Atan2 returns the arc tangent of y/x, using
 // the signs of the two to determine the quadrant
 // of the return value.
 //
 // Special cases are (in order):
+//
 //	Atan2(y, NaN) = NaN
 //	Atan2(NaN, x) = NaN
 //	Atan2(+0, x>=0) = +0
@@ -117,93 +214,150 @@ func Atan(x float32) float32 { return float32(m.Atan(float32(x))) }
 //	Atan2(y<0, -Inf) = -Pi
 //	Atan2(+Inf, x) = +Pi/2
 //	Atan2(-Inf, x) = -Pi/2
-func Atan2(y, x float32) float32 { return float32(m.Atan2(float32(y), float32(x))) }
+func Atan2[T Float](y, x T) T {
+	if Is32[T]() {
+		return T(m32.Atan2(float32(y), float32(x)))
+	}
+	return T(m.Atan2(float64(y), float64(x)))
+}
 
 // Atanh returns the inverse hyperbolic tangent of x.
 //
 // Special cases are:
+//
 //	Atanh(1) = +Inf
 //	Atanh(±0) = ±0
 //	Atanh(-1) = -Inf
 //	Atanh(x) = NaN if x < -1 or x > 1
 //	Atanh(NaN) = NaN
-func Atanh(x float32) float32 { return float32(m.Atanh(float32(x))) }
+func Atanh[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Atanh(float32(x)))
+	}
+	return T(m.Atanh(float64(x)))
+}
 
 // Cbrt returns the cube root of x.
 //
 // Special cases are:
+//
 //	Cbrt(±0) = ±0
 //	Cbrt(±Inf) = ±Inf
 //	Cbrt(NaN) = NaN
-func Cbrt(x float32) float32 { return float32(m.Cbrt(float32(x))) }
+func Cbrt[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Cbrt(float32(x)))
+	}
+	return T(m.Cbrt(float64(x)))
+}
 
 // Ceil returns the least integer value greater than or equal to x.
 //
 // Special cases are:
+//
 //	Ceil(±0) = ±0
 //	Ceil(±Inf) = ±Inf
 //	Ceil(NaN) = NaN
-func Ceil(x float32) float32 { return float32(m.Ceil(float32(x))) }
+func Ceil[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Ceil(float32(x)))
+	}
+	return T(m.Ceil(float64(x)))
+}
 
 // Copysign returns a value with the magnitude
 // of x and the sign of y.
-func Copysign(x, y float32) float32 { return float32(m.Copysign(float32(x), float32(y))) }
-
-// Cosh returns the hyperbolic cosine of x.
-//
-// Special cases are:
-//	Cosh(±0) = 1
-//	Cosh(±Inf) = +Inf
-//	Cosh(NaN) = NaN
-//func Cosh(x float32) float32 { return float32(m.Cosh(float32(x))) }
+func Copysign[T Float](x, y T) T {
+	if Is32[T]() {
+		return T(m32.Copysign(float32(x), float32(y)))
+	}
+	return T(m.Copysign(float64(x), float64(y)))
+}
 
 // Dim returns the maximum of x-y or 0.
 //
 // Special cases are:
+//
 //	Dim(+Inf, +Inf) = NaN
 //	Dim(-Inf, -Inf) = NaN
 //	Dim(x, NaN) = Dim(NaN, x) = NaN
-func Dim(x, y float32) float32 { return float32(m.Dim(float32(x), float32(y))) }
+func Dim[T Float](x, y T) T {
+	if Is32[T]() {
+		return T(m32.Dim(float32(x), float32(y)))
+	}
+	return T(m.Dim(float64(x), float64(y)))
+}
 
 // Erf returns the error function of x.
 //
 // Special cases are:
+//
 //	Erf(+Inf) = 1
 //	Erf(-Inf) = -1
 //	Erf(NaN) = NaN
-func Erf(x float32) float32 { return float32(m.Erf(float32(x))) }
+func Erf[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Erf(float32(x)))
+	}
+	return T(m.Erf(float64(x)))
+}
 
 // Erfc returns the complementary error function of x.
 //
 // Special cases are:
+//
 //	Erfc(+Inf) = 0
 //	Erfc(-Inf) = 2
 //	Erfc(NaN) = NaN
-func Erfc(x float32) float32 { return float32(m.Erfc(float32(x))) }
+func Erfc[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Erfc(float32(x)))
+	}
+	return T(m.Erfc(float64(x)))
+}
 
 // Exp returns e**x, the base-e exponential of x.
 //
 // Special cases are:
+//
 //	Exp(+Inf) = +Inf
 //	Exp(NaN) = NaN
+//
 // Very large values overflow to 0 or +Inf.
 // Very small values underflow to 1.
-func Exp(x float32) float32 { return float32(m.Exp(float32(x))) }
+func Exp[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Exp(float32(x)))
+	}
+	return T(m.Exp(float64(x)))
+}
 
 // Exp2 returns 2**x, the base-2 exponential of x.
 //
 // Special cases are the same as Exp.
-func Exp2(x float32) float32 { return float32(m.Exp2(float32(x))) }
+func Exp2[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Exp2(float32(x)))
+	}
+	return T(m.Exp2(float64(x)))
+}
 
 // Expm1 returns e**x - 1, the base-e exponential of x minus 1.
 // It is more accurate than Exp(x) - 1 when x is near zero.
 //
 // Special cases are:
+//
 //	Expm1(+Inf) = +Inf
 //	Expm1(-Inf) = -1
 //	Expm1(NaN) = NaN
+//
 // Very large values overflow to -1 or +Inf.
-func Expm1(x float32) float32 { return float32(m.Expm1(float32(x))) }
+func Expm1[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Expm1(float32(x)))
+	}
+	return T(m.Expm1(float64(x)))
+}
 
 // Float32bits returns the IEEE 754 binary representation of f.
 func Float32bits(f float32) uint32 { return m.Float32bits(f) }
@@ -222,10 +376,16 @@ func Float64frombits(b uint64) float64 { return m.Float64frombits(b) }
 // Floor returns the greatest integer value less than or equal to x.
 //
 // Special cases are:
+//
 //	Floor(±0) = ±0
 //	Floor(±Inf) = ±Inf
 //	Floor(NaN) = NaN
-func Floor(x float32) float32 { return float32(m.Floor(float32(x))) }
+func Floor[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Floor(float32(x)))
+	}
+	return T(m.Floor(float64(x)))
+}
 
 // Frexp breaks f into a normalized fraction
 // and an integral power of two.
@@ -233,166 +393,256 @@ func Floor(x float32) float32 { return float32(m.Floor(float32(x))) }
 // with the absolute value of frac in the interval [½, 1).
 //
 // Special cases are:
+//
 //	Frexp(±0) = ±0, 0
 //	Frexp(±Inf) = ±Inf, 0
 //	Frexp(NaN) = NaN, 0
-func Frexp(f float32) (frac float32, exp int) {
-	fr, exp := m.Frexp(float32(f))
-	return float32(fr), exp
+func Frexp[T Float](f T) (frac T, exp int) {
+	if Is32[T]() {
+		a, b := m32.Frexp(float32(f))
+		return T(a), b
+	}
+	a, b := m.Frexp(float64(f))
+	return T(a), b
 }
 
 // Gamma returns the Gamma function of x.
 //
 // Special cases are:
+//
 //	Gamma(+Inf) = +Inf
 //	Gamma(+0) = +Inf
 //	Gamma(-0) = -Inf
 //	Gamma(x) = NaN for integer x < 0
 //	Gamma(-Inf) = NaN
 //	Gamma(NaN) = NaN
-func Gamma(x float32) float32 { return float32(m.Gamma(float32(x))) }
+func Gamma[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Gamma(float32(x)))
+	}
+	return T(m.Gamma(float64(x)))
+}
 
 // Hypot returns Sqrt(p*p + q*q), taking care to avoid
 // unnecessary overflow and underflow.
 //
 // Special cases are:
+//
 //	Hypot(±Inf, q) = +Inf
 //	Hypot(p, ±Inf) = +Inf
 //	Hypot(NaN, q) = NaN
 //	Hypot(p, NaN) = NaN
-func Hypot(p, q float32) float32 { return float32(m.Hypot(float32(p), float32(q))) }
+func Hypot[T Float](p, q T) T {
+	if Is32[T]() {
+		return T(m32.Hypot(float32(p), float32(q)))
+	}
+	return T(m.Hypot(float64(p), float64(q)))
+}
 
 // J0 returns the order-zero Bessel function of the first kind.
 //
 // Special cases are:
+//
 //	J0(±Inf) = 0
 //	J0(0) = 1
 //	J0(NaN) = NaN
-func J0(x float32) float32 { return float32(m.J0(float32(x))) }
+func J0[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.J0(float32(x)))
+	}
+	return T(m.J0(float64(x)))
+}
 
 // J1 returns the order-one Bessel function of the first kind.
 //
 // Special cases are:
+//
 //	J1(±Inf) = 0
 //	J1(NaN) = NaN
-func J1(x float32) float32 { return float32(m.J1(float32(x))) }
+func J1[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.J1(float32(x)))
+	}
+	return T(m.J1(float64(x)))
+}
 
 // Jn returns the order-n Bessel function of the first kind.
 //
 // Special cases are:
+//
 //	Jn(n, ±Inf) = 0
 //	Jn(n, NaN) = NaN
-func Jn(n int, x float32) float32 { return float32(m.Jn(n, float32(x))) }
+func Jn[T Float](n int, x T) T {
+	if Is32[T]() {
+		return T(m32.Jn(n, float32(x)))
+	}
+	return T(m.Jn(n, float64(x)))
+}
 
 // Ldexp is the inverse of Frexp.
 // It returns frac × 2**exp.
 //
 // Special cases are:
+//
 //	Ldexp(±0, exp) = ±0
 //	Ldexp(±Inf, exp) = ±Inf
 //	Ldexp(NaN, exp) = NaN
-func Ldexp(frac float32, exp int) float32 { return float32(m.Ldexp(float32(frac), exp)) }
+func Ldexp[T Float](frac T, exp int) T {
+	if Is32[T]() {
+		return T(m32.Ldexp(float32(frac), exp))
+	}
+	return T(m.Ldexp(float64(frac), exp))
+}
 
 // Lgamma returns the natural logarithm and sign (-1 or +1) of Gamma(x).
 //
 // Special cases are:
+//
 //	Lgamma(+Inf) = +Inf
 //	Lgamma(0) = +Inf
 //	Lgamma(-integer) = +Inf
 //	Lgamma(-Inf) = -Inf
 //	Lgamma(NaN) = NaN
-func Lgamma(x float32) (lgamma float32, sign int) {
-	l, sign := m.Lgamma(float32(x))
-	return float32(l), sign
+func Lgamma[T Float](x T) (lgamma T, sign int) {
+	if Is32[T]() {
+		a, b := m32.Lgamma(float32(x))
+		return T(a), b
+	}
+	a, b := m.Lgamma(float64(x))
+	return T(a), b
 }
 
 // Log returns the natural logarithm of x.
 //
 // Special cases are:
+//
 //	Log(+Inf) = +Inf
 //	Log(0) = -Inf
 //	Log(x < 0) = NaN
 //	Log(NaN) = NaN
-func Log(x float32) float32 { return float32(m.Log(float32(x))) }
+func Log[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Log(float32(x)))
+	}
+	return T(m.Log(float64(x)))
+}
 
 // Log10 returns the decimal logarithm of x.
 // The special cases are the same as for Log.
-func Log10(x float32) float32 { return float32(m.Log10(float32(x))) }
+func Log10[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Log10(float32(x)))
+	}
+	return T(m.Log10(float64(x)))
+}
 
 // Log1p returns the natural logarithm of 1 plus its argument x.
 // It is more accurate than Log(1 + x) when x is near zero.
 //
 // Special cases are:
+//
 //	Log1p(+Inf) = +Inf
 //	Log1p(±0) = ±0
 //	Log1p(-1) = -Inf
 //	Log1p(x < -1) = NaN
 //	Log1p(NaN) = NaN
-func Log1p(x float32) float32 { return float32(m.Log1p(float32(x))) }
+func Log1p[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Log1p(float32(x)))
+	}
+	return T(m.Log1p(float64(x)))
+}
 
 // Log2 returns the binary logarithm of x.
 // The special cases are the same as for Log.
-func Log2(x float32) float32 { return float32(m.Log2(float32(x))) }
+func Log2[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Log2(float32(x)))
+	}
+	return T(m.Log2(float64(x)))
+}
 
 // Max returns the larger of x or y.
 //
 // Special cases are:
+//
 //	Max(x, +Inf) = Max(+Inf, x) = +Inf
 //	Max(x, NaN) = Max(NaN, x) = NaN
 //	Max(+0, ±0) = Max(±0, +0) = +0
 //	Max(-0, -0) = -0
-func Max(x, y float32) float32 { return float32(m.Max(float32(x), float32(y))) }
+func Max[T Float](x, y T) T {
+	if Is32[T]() {
+		return T(m32.Max(float32(x), float32(y)))
+	}
+	return T(m.Max(float64(x), float64(y)))
+}
 
 // Min returns the smaller of x or y.
 //
 // Special cases are:
+//
 //	Min(x, -Inf) = Min(-Inf, x) = -Inf
 //	Min(x, NaN) = Min(NaN, x) = NaN
 //	Min(-0, ±0) = Min(±0, -0) = -0
-func Min(x, y float32) float32 { return float32(m.Min(float32(x), float32(y))) }
+func Min[T Float](x, y T) T {
+	if Is32[T]() {
+		return T(m32.Min(float32(x), float32(y)))
+	}
+	return T(m.Min(float64(x), float64(y)))
+}
 
 // Mod returns the floating-point remainder of x/y.
 // The magnitude of the result is less than y and its
 // sign agrees with that of x.
 //
 // Special cases are:
+//
 //	Mod(±Inf, y) = NaN
 //	Mod(NaN, y) = NaN
 //	Mod(x, 0) = NaN
 //	Mod(x, ±Inf) = x
 //	Mod(x, NaN) = NaN
-func Mod(x, y float32) float32 { return float32(m.Mod(float32(x), float32(y))) }
+func Mod[T Float](x, y T) T {
+	if Is32[T]() {
+		return T(m32.Mod(float32(x), float32(y)))
+	}
+	return T(m.Mod(float64(x), float64(y)))
+}
 
 // Modf returns integer and fractional floating-point numbers
 // that sum to f.  Both values have the same sign as f.
 //
 // Special cases are:
+//
 //	Modf(±Inf) = ±Inf, NaN
 //	Modf(NaN) = NaN, NaN
-func Modf(f float32) (int float32, frac float32) {
-	i, fr := m.Modf(float32(f))
-	return float32(i), float32(fr)
+func Modf[T Float](f T) (int T, frac T) {
+	if Is32[T]() {
+		a, b := m32.Modf(float32(f))
+		return T(a), T(b)
+	}
+	a, b := m.Modf(float64(f))
+	return T(a), T(b)
 }
 
-// Nextafter64 returns the next representable float64 value after x towards y.
+// Nextafter returns the next representable floating-point value after x towards y.
 //
 // Special cases are:
+//
 //	Nextafter(x, x)   = x
 //	Nextafter(NaN, y) = NaN
 //	Nextafter(x, NaN) = NaN
-func Nextafter64(x, y float64) (r float64) { return m.Nextafter64(x, y) }
-
-// Nextafter returns the next representable float32 value after x towards y.
-//
-// Special cases are:
-//	Nextafter32(x, x)   = x
-//	Nextafter32(NaN, y) = NaN
-//	Nextafter32(x, NaN) = NaN
-func Nextafter(x, y float32) (r float32) { return m.Nextafter(x, y) }
+func Nextafter[T Float](x, y T) (r T) {
+	if Is32[T]() {
+		return T(m32.Nextafter(float32(x), float32(y)))
+	}
+	return T(m.Nextafter(float64(x), float64(y)))
+}
 
 // Pow returns x**y, the base-x exponential of y.
 //
 // Special cases are (in order):
+//
 //	Pow(x, ±0) = 1 for any x
 //	Pow(1, y) = 1 for any y
 //	Pow(x, 1) = x for any x
@@ -413,95 +663,164 @@ func Nextafter(x, y float32) (r float32) { return m.Nextafter(x, y) }
 //	Pow(+Inf, y) = +0 for y < 0
 //	Pow(-Inf, y) = Pow(-0, -y)
 //	Pow(x, y) = NaN for finite x < 0 and finite non-integer y
-func Pow(x, y float32) float32 { return float32(m.Pow(float32(x), float32(y))) }
+func Pow[T Float](x, y T) T {
+	if Is32[T]() {
+		return T(m32.Pow(float32(x), float32(y)))
+	}
+	return T(m.Pow(float64(x), float64(y)))
+}
 
 // Pow10 returns 10**e, the base-10 exponential of e.
 //
 // Special cases are:
+//
 //	Pow10(e) = +Inf for e > 309
 //	Pow10(e) = 0 for e < -324
-func Pow10(e int) float32 { return float32(m.Pow10(e)) }
+func Pow10[T Float](e int) T {
+	if Is32[T]() {
+		return T(m32.Pow10(e))
+	}
+	return T(m.Pow10(e))
+}
 
 // Remainder returns the IEEE 754 floating-point remainder of x/y.
 //
 // Special cases are:
+//
 //	Remainder(±Inf, y) = NaN
 //	Remainder(NaN, y) = NaN
 //	Remainder(x, 0) = NaN
 //	Remainder(x, ±Inf) = x
 //	Remainder(x, NaN) = NaN
-func Remainder(x, y float32) float32 { return float32(m.Remainder(float32(x), float32(y))) }
+func Remainder[T Float](x, y T) T {
+	if Is32[T]() {
+		return T(m32.Remainder(float32(x), float32(y)))
+	}
+	return T(m.Remainder(float64(x), float64(y)))
+}
 
 // Signbit returns true if x is negative or negative zero.
-func Signbit(x float32) bool { return m.Signbit(float32(x)) }
+func Signbit[T Float](x T) bool {
+	if Is32[T]() {
+		return m32.Signbit(float32(x))
+	}
+	return m.Signbit(float64(x))
+}
 
 // Sincos returns Sin(x), Cos(x).
 //
 // Special cases are:
+//
 //	Sincos(±0) = ±0, 1
 //	Sincos(±Inf) = NaN, NaN
 //	Sincos(NaN) = NaN, NaN
-func Sincos(x float32) (sin, cos float32) {
-	s, c := m.Sincos(float32(x))
-	return float32(s), float32(c)
+func Sincos[T Float](x T) (sin, cos T) {
+	if Is32[T]() {
+		a, b := m32.Sincos(float32(x))
+		return T(a), T(b)
+	}
+	a, b := m.Sincos(float64(x))
+	return T(a), T(b)
 }
 
 // Sinh returns the hyperbolic sine of x.
 //
 // Special cases are:
+//
 //	Sinh(±0) = ±0
 //	Sinh(±Inf) = ±Inf
 //	Sinh(NaN) = NaN
-func Sinh(x float32) float32 { return float32(m.Sinh(float32(x))) }
+func Sinh[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Sinh(float32(x)))
+	}
+	return T(m.Sinh(float64(x)))
+}
 
 // Tan returns the tangent of the radian argument x.
 //
 // Special cases are:
+//
 //	Tan(±0) = ±0
 //	Tan(±Inf) = NaN
 //	Tan(NaN) = NaN
-func Tan(x float32) float32 { return float32(m.Tan(float32(x))) }
+func Tan[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Tan(float32(x)))
+	}
+	return T(m.Tan(float64(x)))
+}
 
 // Tanh returns the hyperbolic tangent of x.
 //
 // Special cases are:
+//
 //	Tanh(±0) = ±0
 //	Tanh(±Inf) = ±1
 //	Tanh(NaN) = NaN
-func Tanh(x float32) float32 { return float32(m.Tanh(float32(x))) }
+func Tanh[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Tanh(float32(x)))
+	}
+	return T(m.Tanh(float64(x)))
+}
 
 // Trunc returns the integer value of x.
 //
 // Special cases are:
+//
 //	Trunc(±0) = ±0
 //	Trunc(±Inf) = ±Inf
 //	Trunc(NaN) = NaN
-func Trunc(x float32) float32 { return float32(m.Trunc(float32(x))) }
+func Trunc[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Trunc(float32(x)))
+	}
+	return T(m.Trunc(float64(x)))
+}
 
 // Y0 returns the order-zero Bessel function of the second kind.
 //
 // Special cases are:
+//
 //	Y0(+Inf) = 0
 //	Y0(0) = -Inf
 //	Y0(x < 0) = NaN
 //	Y0(NaN) = NaN
-func Y0(x float32) float32 { return float32(m.Y0(float32(x))) }
+func Y0[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Y0(float32(x)))
+	}
+	return T(m.Y0(float64(x)))
+}
 
 // Y1 returns the order-one Bessel function of the second kind.
 //
 // Special cases are:
+//
 //	Y1(+Inf) = 0
 //	Y1(0) = -Inf
 //	Y1(x < 0) = NaN
 //	Y1(NaN) = NaN
-func Y1(x float32) float32 { return float32(m.Y1(float32(x))) }
+func Y1[T Float](x T) T {
+	if Is32[T]() {
+		return T(m32.Y1(float32(x)))
+	}
+	return T(m.Y1(float64(x)))
+}
 
 // Yn returns the order-n Bessel function of the second kind.
 //
 // Special cases are:
+//
 //	Yn(n, +Inf) = 0
 //	Yn(n > 0, 0) = -Inf
 //	Yn(n < 0, 0) = +Inf if n is odd, -Inf if n is even
 //	Y1(n, x < 0) = NaN
 //	Y1(n, NaN) = NaN
-func Yn(n int, x float32) float32 { return float32(m.Yn(n, float32(x))) }
+func Yn[T Float](n int, x T) T {
+	if Is32[T]() {
+		return T(m32.Yn(n, float32(x)))
+	}
+	return T(m.Yn(n, float64(x)))
+}
@@ -7,8 +7,9 @@ import (
 	"github.com/WinPooh32/series/math"
 )
 
-// ResampleOrigin is the timestamp (milliseconds) on which to adjust the grouping.
-// The timezone of origin must match the timezone of the index.
+// ResampleOrigin is the timestamp on which to adjust the grouping.
+// Index values are treated as Unix time in nanoseconds,
+// see WithLocation for the time zone of the origin.
 type ResampleOrigin int
 
 const (
@@ -16,7 +17,7 @@ const (
 	OriginEpoch ResampleOrigin = iota
 	// OriginStart is the first value of the timeseries.
 	OriginStart
-	// OriginStartDay is the first day at midnight of the timeseries.
+	// OriginStartDay is the midnight of the first day of the timeseries.
 	OriginStartDay
 )
 
@@ -30,243 +31,538 @@ const (
 	InterpolationPad
 	// InterpolationNone doesn't fill NaNs.
 	InterpolationNone
+	// InterpolationNearest fills NaNs by the nearest known value.
+	InterpolationNearest
+	// InterpolationBackfill fills NaNs by the next known value.
+	InterpolationBackfill
+	// InterpolationTime fills NaNs by linear interpolation weighted by the index distance.
+	InterpolationTime
+	// InterpolationSpline fills NaNs by the natural cubic spline.
+	InterpolationSpline
+	// InterpolationAkima fills NaNs by the Akima spline.
+	InterpolationAkima
+
+	// interpolationPolynomial is made by InterpolationPolynomial function.
+	interpolationPolynomial
 )
 
 // Resampler resamples time-series data.
 // Not full groups will are filled by NaNs.
-type Resampler struct {
-	data   Data
-	freq   int64
-	origin ResampleOrigin
+type Resampler[T Float] struct {
+	resampleRule
+	data Data[T]
 }
 
 // Sum applies sum function to sample group.
-func (res Resampler) Sum() Data {
-	return res.downsample(Sum)
+func (res Resampler[T]) Sum() Data[T] {
+	return res.downsample(Sum[T])
 }
 
 // Mean applies mean function to sample group.
-func (res Resampler) Mean() Data {
-	return res.downsample(Mean)
+func (res Resampler[T]) Mean() Data[T] {
+	return res.downsample(Mean[T])
 }
 
 // Min applies min function to sample group.
-func (res Resampler) Min() Data {
-	return res.downsample(Min)
+func (res Resampler[T]) Min() Data[T] {
+	return res.downsample(Min[T])
 }
 
 // Max applies max function to sample group.
-func (res Resampler) Max() Data {
-	return res.downsample(Max)
+func (res Resampler[T]) Max() Data[T] {
+	return res.downsample(Max[T])
 }
 
 // Median applies median function to sample group.
-func (res Resampler) Median() Data {
-	var tmp []DType
+func (res Resampler[T]) Median() Data[T] {
+	var tmp []T
 
-	fn := func(data Data) DType {
-		tmp = append(tmp[:0], data.values...)
-		sort.Sort(DTypeSlice(tmp))
-		return Median(data)
+	fn := func(data Data[T]) T {
+		tmp = data.validValues(tmp[:0])
+		sort.Sort(FloatSlice[T](tmp))
+		return Median(MakeValues(tmp))
 	}
 
 	return res.downsample(fn)
 }
 
 // First applies first function to sample group.
-func (res Resampler) First() Data {
-	return res.downsample(First)
+func (res Resampler[T]) First() Data[T] {
+	return res.downsample(First[T])
 }
 
 // Last applies last function to sample group.
-func (res Resampler) Last() Data {
-	return res.downsample(Last)
+func (res Resampler[T]) Last() Data[T] {
+	return res.downsample(Last[T])
 }
 
-// Apply applies custom function to sample group.
-func (res Resampler) Apply(agg AggregateFunc) Data {
-	return res.downsample(agg)
+// Count counts not NA values of sample group.
+func (res Resampler[T]) Count() Data[T] {
+	return res.downsample(Count[T])
 }
 
-// Interpolate fills all NaNs between known values after applied upsamping.
-func (res Resampler) Interpolate(method InterpolationMethod) Data {
-	result := res.upsample()
-
-	switch method {
-	case InterpolationLinear:
-		return result.Lerp()
-	case InterpolationPad:
-		return result.Pad()
-	case InterpolationNone:
-		return result
-	default:
-		return result
-	}
+// Prod applies product function to sample group.
+func (res Resampler[T]) Prod() Data[T] {
+	return res.downsample(Prod[T])
 }
 
-func (res Resampler) upsample() Data {
-	index := res.data.index
-	values := res.data.values
+// NUnique counts distinct values of sample group.
+func (res Resampler[T]) NUnique() Data[T] {
+	return res.downsample(NUnique[T])
+}
 
-	firstIdx := index[0]
-	lastIdx := index[len(index)-1]
+// Var applies variance function to sample group.
+// Ddof - Delta Degrees of Freedom, see Var.
+func (res Resampler[T]) Var(ddof int) Data[T] {
+	return res.downsample(func(data Data[T]) T { return Var(data, ddof) })
+}
 
-	oldFreq := DType(res.data.freq)
-	newFreq := DType(res.freq)
+// Std applies standard deviation function to sample group.
+// Ddof - Delta Degrees of Freedom, see Var.
+func (res Resampler[T]) Std(ddof int) Data[T] {
+	return res.downsample(func(data Data[T]) T { return math.Sqrt(Var(data, ddof)) })
+}
 
-	freq := math.Ceil(oldFreq / newFreq)
+// Sem applies standard error of the mean function to sample group.
+// Ddof - Delta Degrees of Freedom, see Var.
+func (res Resampler[T]) Sem(ddof int) Data[T] {
+	return res.downsample(func(data Data[T]) T { return Sem(data, ddof) })
+}
 
-	newCap := int((lastIdx-firstIdx)/int64(newFreq) + 1)
+// Quantile applies q-th quantile function to sample group.
+func (res Resampler[T]) Quantile(q T, interpolation QuantileInterpolation) Data[T] {
+	var tmp []T
 
-	var (
-		newIndex []int64
-		newData  []DType
-	)
+	fn := func(data Data[T]) T {
+		tmp = data.validValues(tmp[:0])
+		sort.Sort(FloatSlice[T](tmp))
+		return quantileSorted(tmp, q, interpolation)
+	}
+
+	return res.downsample(fn)
+}
 
-	if cap(index) >= newCap {
-		newIndex = index[:0]
-	} else {
-		newIndex = make([]int64, 0, newCap)
+// Apply applies custom function to sample group.
+func (res Resampler[T]) Apply(agg AggregateFunc[T]) Data[T] {
+	return res.downsample(agg)
+}
+
+// Agg applies every aggregation function to sample groups.
+// Groups are computed once and all results share the same index.
+func (res Resampler[T]) Agg(fns ...AggregateFunc[T]) []Data[T] {
+	for _, agg := range fns {
+		if agg == nil {
+			panic("aggregation func must not be nil!")
+		}
 	}
 
-	newIndex = res.reindex(newIndex, firstIdx, lastIdx, int(newFreq))
+	results := make([]Data[T], len(fns))
 
-	if cap(values) >= newCap {
-		newData = values[:0]
-	} else {
-		newData = make([]DType, 0, newCap)
+	if len(res.data.index) == 0 {
+		for i := range results {
+			results[i] = MakeData(res.freq, []int64{}, []T{})
+		}
+		return results
 	}
 
-	newData = res.fillData(newData[:newCap], values, int(freq))
+	data := res.data
 
-	return MakeData(res.freq, newIndex, newData)
-}
+	framesTotal := res.groupsCap(data.index, data.freq)
+
+	aggIndex := make([]int64, 0, framesTotal)
+	bounds := make([][2]int, 0, framesTotal)
+
+	res.groups(data.index, func(label int64, beg, end int) {
+		aggIndex = append(aggIndex, label)
+		bounds = append(bounds, [2]int{beg, end})
+	})
 
-func (Resampler) reindex(dst []int64, startValue, endValue int64, freq int) []int64 {
-	for value := startValue; value <= endValue; value += int64(freq) {
-		dst = append(dst, value)
+	for i, agg := range fns {
+		aggValue := make([]T, len(bounds))
+		for j, b := range bounds {
+			aggValue[j] = agg(data.Slice(b[0], b[1]))
+		}
+		results[i] = MakeData(res.freq, aggIndex, aggValue).maskedLike(data)
 	}
-	return dst
+
+	return results
 }
 
-func (Resampler) fillData(dst, src []DType, step int) []DType {
-	// under the hood src and dst can be same array,
-	// then fill dst at backward direction.
-	i := len(dst) - 1
-	j := len(src) - 1
+// AggMap applies named aggregation functions to sample groups.
+// Groups are computed once, columns of the result frame are sorted by names.
+func (res Resampler[T]) AggMap(fns map[string]AggregateFunc[T]) Frame[T] {
+	names := make([]string, 0, len(fns))
+	for name := range fns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
 
-	for i >= 0 && j >= 0 {
-		dst[i] = src[j]
+	aggs := make([]AggregateFunc[T], len(names))
+	for i, name := range names {
+		aggs[i] = fns[name]
+	}
 
-		// Fill new values by NaNs.
-		next := i - step
+	return MakeFrameFromData(names, res.Agg(aggs...)...)
+}
 
-		beg := next
-		end := i
+// OHLC computes open, high, low and close values of sample group in one pass.
+// Result columns are named "open", "high", "low" and "close".
+func (res Resampler[T]) OHLC() Frame[T] {
+	data := res.data
 
-		if beg < 0 {
-			beg = 0
-		}
+	total := res.groupsCap(data.index, data.freq)
 
-		between := dst[beg:end]
+	var (
+		index = make([]int64, 0, total)
+		open  = make([]T, 0, total)
+		high  = make([]T, 0, total)
+		low   = make([]T, 0, total)
+		close = make([]T, 0, total)
+	)
 
-		for k := len(between) - 1; k >= 0; k-- {
-			between[k] = math.NaN()
+	res.groups(data.index, func(label int64, beg, end int) {
+		o, h, l, c := math.NaN[T](), math.NaN[T](), math.NaN[T](), math.NaN[T]()
+		found := false
+
+		for i := beg; i < end; i++ {
+			if data.isNA(i) {
+				continue
+			}
+			v := data.values[i]
+			if !found {
+				o, h, l = v, v, v
+				found = true
+			}
+			if v > h {
+				h = v
+			}
+			if v < l {
+				l = v
+			}
+			c = v
 		}
 
-		i = next
-		j--
-	}
+		index = append(index, label)
+		open = append(open, o)
+		high = append(high, h)
+		low = append(low, l)
+		close = append(close, c)
+	})
+
+	return MakeFrameFromData(
+		[]string{"open", "high", "low", "close"},
+		MakeData(res.freq, index, open).maskedLike(data),
+		MakeData(res.freq, index, high).maskedLike(data),
+		MakeData(res.freq, index, low).maskedLike(data),
+		MakeData(res.freq, index, close).maskedLike(data),
+	)
+}
+
+// Interpolate fills all NaNs between known values after applied upsamping.
+func (res Resampler[T]) Interpolate(method InterpolationMethod) Data[T] {
+	return res.upsample().Interpolate(method)
+}
 
-	return dst
+// InterpolateLimit fills NaNs restricted by limit after applied upsamping.
+// Set FillLimit.MaxGap to keep NaNs of long gaps between source values.
+func (res Resampler[T]) InterpolateLimit(method InterpolationMethod, limit FillLimit) Data[T] {
+	return res.upsample().InterpolateLimit(method, limit)
 }
 
-func (res Resampler) downsample(agg AggregateFunc) Data {
-	if agg == nil {
-		panic("aggregation func must not be nil!")
+// upsample places every source value into the group matching its actual timestamp,
+// so the source can be irregularly spaced.
+// The first valid value is taken if several values fall into one group,
+// empty groups are filled by NaNs.
+func (res Resampler[T]) upsample() Data[T] {
+	return res.downsample(First[T])
+}
+
+func (res Resampler[T]) downsample(agg AggregateFunc[T]) Data[T] {
+	return res.Agg(agg)[0]
+}
+
+// Side is the side of resampling group.
+type Side int
+
+const (
+	// SideLeft is the beginning of the group.
+	SideLeft Side = iota
+	// SideRight is the end of the group.
+	SideRight
+)
+
+// ResampleOption configures resampling rule.
+type ResampleOption func(rule *resampleRule)
+
+// WithLocation sets time zone of the index timestamps.
+// Origins are aligned to midnight of loc and periods of whole days
+// follow its wall-clock days, which are 23 or 25 hours long at DST transitions.
+// UTC is used by default.
+func WithLocation(loc *time.Location) ResampleOption {
+	if loc == nil {
+		panic("location must not be nil")
+	}
+	return func(rule *resampleRule) {
+		rule.loc = loc
 	}
+}
 
-	if len(res.data.index) == 0 {
-		return res.data.Clone()
+// WithClosed sets which side of the group interval is closed.
+// Groups are closed at the left side by default: [begin, end).
+func WithClosed(side Side) ResampleOption {
+	mustBeSide(side)
+	return func(rule *resampleRule) {
+		rule.closed = side
 	}
+}
 
-	data := res.data
+// WithLabel sets which side of the group interval labels the group.
+// Groups are labeled by the left side by default, MonthEnd and Weeks periods are labeled by the right side.
+func WithLabel(side Side) ResampleOption {
+	mustBeSide(side)
+	return func(rule *resampleRule) {
+		rule.label = side
+	}
+}
 
-	// frame is samples count of resampling group.
-	frame := int(math.Ceil(DType(res.freq) / DType(res.data.freq)))
-	framesTotal := int(math.Ceil(DType(res.data.Len()) / DType(frame)))
+// WithOffset shifts group boundaries by offset from the origin.
+func WithOffset(offset time.Duration) ResampleOption {
+	return func(rule *resampleRule) {
+		rule.offset = int64(offset)
+	}
+}
 
-	srcIndex := data.Index()
+// WithOrigin aligns groups to the custom origin timestamp instead of the ResampleOrigin argument.
+func WithOrigin(ts int64) ResampleOption {
+	return func(rule *resampleRule) {
+		rule.origin = originCustom
+		rule.originTS = ts
+	}
+}
 
-	aggValue := make([]DType, 0, framesTotal)
-	aggIndex := make([]int64, 0, framesTotal)
+func mustBeSide(side Side) {
+	if side != SideLeft && side != SideRight {
+		panic("side must be SideLeft or SideRight")
+	}
+}
 
-	idx := res.align(srcIndex[0])
-	beg := 0
-	end := 0
+const nanosPerDay = int64(24 * time.Hour)
+
+// originCustom is the origin set by WithOrigin option.
+const originCustom ResampleOrigin = -1
+
+// resampleRule describes how index is split into resampling groups.
+type resampleRule struct {
+	freq     int64
+	origin   ResampleOrigin
+	originTS int64
+	offset   int64
+	closed   Side
+	label    Side
+	loc      *time.Location
+	// cal is the calendar period, freq is its nominal length then.
+	cal CalendarFreq
+}
 
-	if idx < srcIndex[0] {
-		dt := srcIndex[0] - idx
+func makeResampleRule(freq int64, origin ResampleOrigin, opts ...ResampleOption) resampleRule {
+	return newResampleRule(freq, CalendarFreq{}, origin, opts)
+}
 
-		delta := DType(dt)
-		freq := DType(res.data.freq)
-		absent := int(delta / freq)
+func makeCalendarRule(freq CalendarFreq, origin ResampleOrigin, opts ...ResampleOption) resampleRule {
+	if freq.IsZero() {
+		panic("calendar frequency must not be zero")
+	}
+	return newResampleRule(freq.nominal(), freq, origin, opts)
+}
 
-		end = frame - absent
+func newResampleRule(freq int64, cal CalendarFreq, origin ResampleOrigin, opts []ResampleOption) resampleRule {
+	if freq <= 0 {
+		panic("resampling frequency must be greater than zero")
+	}
+	switch origin {
+	case OriginEpoch, OriginStart, OriginStartDay:
+	default:
+		panic("unknown resampling origin type")
+	}
 
-		view := data.Slice(0, end)
-		value := agg(view)
+	rule := resampleRule{
+		freq:   freq,
+		origin: origin,
+		closed: SideLeft,
+		label:  SideLeft,
+		loc:    time.UTC,
+		cal:    cal,
+	}
+	if cal.labelAt {
+		rule.label = SideRight
+	}
+	for _, opt := range opts {
+		opt(&rule)
+	}
 
-		aggValue = append(aggValue, value)
-		aggIndex = append(aggIndex, idx)
+	return rule
+}
 
-		idx += res.freq
-		beg = end
+// groups splits sorted index into groups of [beg, end) offsets.
+// Each group is passed to cb with its label.
+// Empty groups between the first and the last index values are passed too.
+func (rule resampleRule) groups(index []int64, cb func(label int64, beg, end int)) {
+	if len(index) == 0 {
+		return
 	}
 
-	for {
-		var view Data
+	idx := rule.align(index[0])
+	beg := 0
 
-		untilTS := idx + res.freq
+	for {
+		untilTS := rule.step(idx, 1)
 
-		end = beg
-		for ; end < len(srcIndex) && srcIndex[end] < untilTS; end++ {
+		end := beg
+		for ; end < len(index) && rule.before(index[end], untilTS); end++ {
 		}
 
-		if end == beg && end >= len(srcIndex) {
+		if end == beg && end >= len(index) {
 			break
 		}
 
-		view = data.Slice(beg, end)
+		cb(rule.labelOf(idx, untilTS), beg, end)
+
 		beg = end
+		idx = untilTS
+	}
+}
 
-		value := agg(view)
+// before reports whether point belongs to the group ending at end.
+func (rule resampleRule) before(point, end int64) bool {
+	if rule.closed == SideRight {
+		return point <= end
+	}
+	return point < end
+}
 
-		aggValue = append(aggValue, value)
-		aggIndex = append(aggIndex, idx)
+// groupsCap returns estimated count of groups.
+func (rule resampleRule) groupsCap(index []int64, srcFreq int64) int {
+	if len(index) == 0 || srcFreq <= 0 {
+		return 0
+	}
+	// frame is samples count of resampling group.
+	frame := (rule.freq + srcFreq - 1) / srcFreq
+	return int((int64(len(index)) + frame - 1) / frame)
+}
 
-		idx = untilTS
+// days returns count of calendar days in the period or zero
+// if the period is not a whole number of days.
+func (rule resampleRule) days() int {
+	if rule.freq%nanosPerDay != 0 {
+		return 0
 	}
+	return int(rule.freq / nanosPerDay)
+}
 
-	return MakeData(res.freq, aggIndex, aggValue)
+// step returns boundary of the group which is periods groups away from the group beginning at start.
+func (rule resampleRule) step(start int64, periods int) int64 {
+	switch {
+	case !rule.cal.IsZero():
+		ordinal := rule.cal.ordinal(rule.time(start-rule.offset)) + int64(periods*rule.cal.n)
+		return rule.cal.start(ordinal, rule.loc).UnixNano() + rule.offset
+	case rule.days() > 0:
+		return rule.time(start).AddDate(0, 0, periods*rule.days()).UnixNano()
+	default:
+		return start + int64(periods)*rule.freq
+	}
 }
 
-func (res Resampler) align(point int64) int64 {
-	var newPoint int64
+// labelOf returns label of the group between start and end boundaries.
+func (rule resampleRule) labelOf(start, end int64) int64 {
+	label := start
+	if rule.label == SideRight {
+		label = end
+	}
+	if rule.cal.labelAt {
+		// Label by the last day of period.
+		label = rule.time(label).AddDate(0, 0, -1).UnixNano()
+	}
+	return label
+}
+
+// align returns beginning of the first group containing point.
+func (rule resampleRule) align(point int64) int64 {
+	start := rule.floor(point)
+	if rule.closed == SideRight && start == point {
+		start = rule.step(start, -1)
+	}
+	return start
+}
+
+// floor returns the nearest group boundary not after point.
+func (rule resampleRule) floor(point int64) int64 {
+	if !rule.cal.IsZero() {
+		// Calendar periods always begin at their boundaries,
+		// origin selects the first of n periods only.
+		var (
+			n       = int64(rule.cal.n)
+			ordinal = rule.cal.ordinal(rule.time(point - rule.offset))
+			base    = ordinal
+		)
+		switch rule.origin {
+		case OriginEpoch:
+			base = 0
+		case originCustom:
+			base = rule.cal.ordinal(rule.time(rule.originTS - rule.offset))
+		}
+		ordinal = base + floorDiv(ordinal-base, n)*n
+		return rule.cal.start(ordinal, rule.loc).UnixNano() + rule.offset
+	}
 
-	freq := res.freq
+	var origin time.Time
 
-	switch res.origin {
+	switch rule.origin {
 	case OriginStart:
-		newPoint = point
+		origin = rule.time(point)
 	case OriginEpoch:
-		newPoint = freq * (point / freq)
+		origin = time.Date(1970, time.January, 1, 0, 0, 0, 0, rule.loc)
 	case OriginStartDay:
-		t := time.Unix(0, point)
-		dayStart := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
-		point = int64(dayStart.Sub(t))
-		newPoint = dayStart.UnixNano() + (freq * (point / freq))
+		origin = startOfDay(rule.time(point))
+	case originCustom:
+		origin = rule.time(rule.originTS)
 	}
 
-	return newPoint
+	origin = origin.Add(time.Duration(rule.offset))
+
+	if days := int64(rule.days()); days > 0 {
+		elapsed := floorDiv(civilDay(rule.time(point))-civilDay(origin), days) * days
+		start := origin.AddDate(0, 0, int(elapsed)).UnixNano()
+		if start > point {
+			start = rule.step(start, -1)
+		}
+		return start
+	}
+
+	start := origin.UnixNano()
+
+	return start + floorDiv(point-start, rule.freq)*rule.freq
+}
+
+// time converts index value to the time at rule's location.
+func (rule resampleRule) time(point int64) time.Time {
+	return time.Unix(0, point).In(rule.loc)
+}
+
+// startOfDay returns midnight of t's day at t's location.
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// civilDay returns count of calendar days since 1970-01-01 till t's date.
+func civilDay(t time.Time) int64 {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60)
+}
+
+// floorDiv divides a by b rounding the quotient toward negative infinity.
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
 }
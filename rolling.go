@@ -0,0 +1,653 @@
+package series
+
+import (
+	"github.com/WinPooh32/series/math"
+)
+
+// rollingKernel is the incremental aggregation of rolling window values.
+// Only valid values are passed to add and remove,
+// values leave the window in the same order as they came in.
+type rollingKernel[T Float] interface {
+	add(i int, v T)
+	remove(i int, v T)
+	// value returns aggregation of count values of the window.
+	value(count int) T
+}
+
+// roll applies kernel to every window of data.
+// Every value enters and leaves the kernel once, so aggregation costs O(n) kernel updates.
+func (w Window[T]) roll(kernel rollingKernel[T]) Data[T] {
+	var (
+		out    = w.output()
+		values = out.values
+		step   = w.step()
+	)
+
+	w.scan(kernel, func(i, l, r, count int) {
+		if w.ready(l, r, count) {
+			values[i/step] = kernel.value(count)
+		} else {
+			values[i/step] = math.NaN[T]()
+		}
+	})
+
+	return out.maskedLike(w.data)
+}
+
+// output makes series of window results, there is the result for every step-th value of data.
+func (w Window[T]) output() Data[T] {
+	var (
+		step  = w.step()
+		n     = (len(w.data.values) + step - 1) / step
+		index = make([]int64, n)
+	)
+
+	for k := range index {
+		index[k] = w.data.index[k*step]
+	}
+
+	return Data[T]{
+		freq:   w.data.freq * int64(step),
+		index:  index,
+		values: make([]T, n),
+	}
+}
+
+// scan moves window bounds through data feeding valid values to kernel.
+// emit is called for every output offset with [l, r) bounds and count of valid values of its window.
+func (w Window[T]) scan(kernel rollingKernel[T], emit func(i, l, r, count int)) {
+	if w.dur == 0 && w.len <= 0 {
+		panic("window size must be greater than zero")
+	}
+
+	var (
+		data = w.data
+
+		l, r, count int
+	)
+
+	w.bounds(func(i, lo, hi int) {
+		for ; r < hi; r++ {
+			if !data.isNA(r) {
+				kernel.add(r, data.values[r])
+				count++
+			}
+		}
+		for ; l < lo; l++ {
+			if !data.isNA(l) {
+				kernel.remove(l, data.values[l])
+				count--
+			}
+		}
+
+		emit(i, lo, hi, count)
+	})
+}
+
+// bounds calls cb with offset of every step-th output value and [l, r) bounds of its window.
+// Both bounds never move backward.
+func (w Window[T]) bounds(cb func(i, l, r int)) {
+	if w.dur > 0 {
+		w.durationBounds(cb)
+		return
+	}
+
+	var (
+		lo, hi = w.offsets()
+		n      = len(w.data.values)
+	)
+
+	for i := 0; i < n; i += w.step() {
+		l := i - lo
+		if l < 0 {
+			l = 0
+		}
+		r := i + hi
+		if r > n {
+			r = n
+		}
+		if r < l {
+			r = l
+		}
+		cb(i, l, r)
+	}
+}
+
+// offsets returns distances from the current value to [i-lo, i+hi) bounds of the fixed window.
+func (w Window[T]) offsets() (lo, hi int) {
+	lo, hi = w.len-1, 1
+	switch w.opts.closed {
+	case ClosedLeft:
+		lo, hi = w.len, 0
+	case ClosedBoth:
+		lo, hi = w.len, 1
+	case ClosedNeither:
+		lo, hi = w.len-1, 0
+	}
+
+	if w.opts.center {
+		shift := (w.len - 1) / 2
+		lo -= shift
+		hi += shift
+	}
+
+	return lo, hi
+}
+
+// durationBounds moves two pointers through sorted index,
+// the window of the value at ts spans index values from ts-dur to ts,
+// the centered window spans index values from ts-dur/2 to ts+dur/2.
+func (w Window[T]) durationBounds(cb func(i, l, r int)) {
+	var (
+		index  = w.data.index
+		n      = len(index)
+		closed = w.opts.closed
+		left   = closed == ClosedLeft || closed == ClosedBoth
+		right  = closed == ClosedRight || closed == ClosedBoth
+
+		l, r int
+	)
+
+	for i := 0; i < n; i += w.step() {
+		ts := index[i]
+
+		var shift int64
+		if w.opts.center {
+			shift = w.dur / 2
+		}
+
+		begin := ts - w.dur + shift
+		for l < n && (index[l] < begin || (!left && index[l] == begin)) {
+			l++
+		}
+
+		switch end := ts + shift; {
+		case w.opts.center:
+			for r < n && (index[r] < end || (right && index[r] == end)) {
+				r++
+			}
+		case right:
+			r = i + 1
+		default:
+			// Values sharing the current timestamp are excluded too.
+			for r < i && index[r] < ts {
+				r++
+			}
+		}
+		if r < l {
+			r = l
+		}
+
+		cb(i, l, r)
+	}
+}
+
+// step returns distance between offsets of window results.
+func (w Window[T]) step() int {
+	if w.opts.step > 1 {
+		return w.opts.step
+	}
+	return 1
+}
+
+// span returns count of values of the complete fixed window.
+func (w Window[T]) span() int {
+	switch w.opts.closed {
+	case ClosedBoth:
+		return w.len + 1
+	case ClosedNeither:
+		return w.len - 1
+	default:
+		return w.len
+	}
+}
+
+// capacity returns the maximum count of window values.
+func (w Window[T]) capacity() int {
+	if w.dur > 0 {
+		return len(w.data.values)
+	}
+	return w.span()
+}
+
+// ready reports whether the window of [l, r) bounds with count valid values can be aggregated.
+// By default fixed windows must be complete, time windows need a valid value only.
+func (w Window[T]) ready(l, r, count int) bool {
+	if w.opts.minPeriods > 0 {
+		return count >= w.opts.minPeriods
+	}
+	if w.dur > 0 {
+		return count > 0
+	}
+	return r-l >= w.span() && count > 0
+}
+
+// rollingCount counts valid values only.
+type rollingCount[T Float] struct{}
+
+func (rollingCount[T]) add(i int, v T)    {}
+func (rollingCount[T]) remove(i int, v T) {}
+
+func (rollingCount[T]) value(count int) T {
+	return T(count)
+}
+
+// rollingSum is the running sum with Neumaier compensation.
+// Non-finite values are counted apart, so they don't poison the running sum
+// after leaving the window.
+type rollingSum[T Float] struct {
+	sum, comp T
+
+	finite, nan, posInf, negInf int
+}
+
+func (k *rollingSum[T]) add(i int, v T) {
+	switch {
+	case v-v == 0:
+		k.sum, k.comp = neumaierAdd(k.sum, k.comp, v)
+		k.finite++
+	case v != v:
+		k.nan++
+	case v > 0:
+		k.posInf++
+	default:
+		k.negInf++
+	}
+}
+
+func (k *rollingSum[T]) remove(i int, v T) {
+	switch {
+	case v-v == 0:
+		k.sum, k.comp = neumaierAdd(k.sum, k.comp, -v)
+		k.finite--
+		if k.finite == 0 {
+			// Drop accumulated rounding error of the empty window.
+			k.sum, k.comp = 0, 0
+		}
+	case v != v:
+		k.nan--
+	case v > 0:
+		k.posInf--
+	default:
+		k.negInf--
+	}
+}
+
+func (k *rollingSum[T]) value(count int) T {
+	switch {
+	case k.nan > 0 || (k.posInf > 0 && k.negInf > 0):
+		return math.NaN[T]()
+	case k.posInf > 0:
+		return math.Inf[T](1)
+	case k.negInf > 0:
+		return math.Inf[T](-1)
+	}
+	return k.sum + k.comp
+}
+
+// rollingMean is the running mean.
+type rollingMean[T Float] struct {
+	rollingSum[T]
+}
+
+func (k *rollingMean[T]) value(count int) T {
+	return k.rollingSum.value(count) / T(count)
+}
+
+// rollingSorted keeps the window values sorted.
+// NaN values are counted apart.
+type rollingSorted[T Float] struct {
+	list skiplist[T]
+	nan  int
+}
+
+func (k *rollingSorted[T]) add(i int, v T) {
+	if v != v {
+		k.nan++
+		return
+	}
+	k.list.Insert(v)
+}
+
+func (k *rollingSorted[T]) remove(i int, v T) {
+	if v != v {
+		k.nan--
+		return
+	}
+	k.list.Remove(v)
+}
+
+// value returns count of sorted values.
+func (k *rollingSorted[T]) value(count int) T {
+	return T(k.list.Len())
+}
+
+// rollingQuantile is the running quantile of the sorted window values.
+// Any NaN value makes the quantile NaN.
+type rollingQuantile[T Float] struct {
+	rollingSorted[T]
+	q             T
+	interpolation QuantileInterpolation
+}
+
+func (k *rollingQuantile[T]) value(count int) T {
+	if k.nan > 0 {
+		return math.NaN[T]()
+	}
+	return quantileAt(k.list.Len(), k.list.At, k.q, k.interpolation)
+}
+
+// rollingExtremum is the monotonic deque of window values.
+// The front of the deque is the first occurrence of the window minimum (or maximum),
+// every value is pushed and popped once, so updates take O(1) amortized time.
+// NaN values are skipped.
+type rollingExtremum[T Float] struct {
+	offsets []int
+	values  []T
+	head    int
+	max     bool
+}
+
+func (k *rollingExtremum[T]) add(i int, v T) {
+	if v != v {
+		return
+	}
+
+	for n := len(k.values); n > k.head && k.dominates(v, k.values[n-1]); n-- {
+		k.offsets = k.offsets[:n-1]
+		k.values = k.values[:n-1]
+	}
+
+	// Compact popped front to keep memory proportional to the window.
+	if k.head >= 1024 && 2*k.head >= len(k.values) {
+		n := copy(k.offsets, k.offsets[k.head:])
+		copy(k.values, k.values[k.head:])
+		k.offsets = k.offsets[:n]
+		k.values = k.values[:n]
+		k.head = 0
+	}
+
+	k.offsets = append(k.offsets, i)
+	k.values = append(k.values, v)
+}
+
+// dominates reports whether v replaces earlier value u in the deque.
+// Equal values are kept, so the first occurrence stays in front.
+func (k *rollingExtremum[T]) dominates(v, u T) bool {
+	if k.max {
+		return v > u
+	}
+	return v < u
+}
+
+func (k *rollingExtremum[T]) remove(i int, v T) {
+	if k.head < len(k.offsets) && k.offsets[k.head] == i {
+		k.head++
+	}
+}
+
+func (k *rollingExtremum[T]) value(count int) T {
+	if k.head == len(k.values) {
+		return math.NaN[T]()
+	}
+	return k.values[k.head]
+}
+
+// arg returns offset of the window extremum or -1 if there are no values.
+func (k *rollingExtremum[T]) arg() int {
+	if k.head == len(k.offsets) {
+		return -1
+	}
+	return k.offsets[k.head]
+}
+
+// rollingCov is the running co-moment of the window values and paired values y.
+// Means and co-moments are updated by Welford's method.
+// Non-finite pairs are counted apart, any of them makes the result NaN.
+type rollingCov[T Float] struct {
+	y    []T
+	ddof int
+	corr bool
+
+	n, nonFinite int
+
+	meanX, meanY T
+	// cxy is the co-moment, cxx and cyy are the second moments.
+	cxy, cxx, cyy T
+
+	// Lengths of runs of equal last values, the window is constant
+	// if the run covers it. So rounding errors don't leak into moments of constant windows.
+	lastX, lastY T
+	runX, runY   int
+}
+
+func (k *rollingCov[T]) add(i int, x T) {
+	y := k.y[i]
+	if x-x != 0 || y-y != 0 {
+		k.nonFinite++
+		return
+	}
+
+	k.n++
+	n := T(k.n)
+
+	k.runX = nextRun(k.runX, k.lastX, x)
+	k.runY = nextRun(k.runY, k.lastY, y)
+	k.lastX, k.lastY = x, y
+
+	dx := x - k.meanX
+	dy := y - k.meanY
+	k.meanX += dx / n
+	k.meanY += dy / n
+	k.cxy += dx * (y - k.meanY)
+	k.cxx += dx * (x - k.meanX)
+	k.cyy += dy * (y - k.meanY)
+}
+
+func (k *rollingCov[T]) remove(i int, x T) {
+	y := k.y[i]
+	if x-x != 0 || y-y != 0 {
+		k.nonFinite--
+		return
+	}
+
+	k.n--
+	if k.n == 0 {
+		// Drop accumulated rounding error of the empty window.
+		k.meanX, k.meanY, k.cxy, k.cxx, k.cyy = 0, 0, 0, 0, 0
+		k.runX, k.runY = 0, 0
+		return
+	}
+	n := T(k.n)
+
+	dx := x - k.meanX
+	dy := y - k.meanY
+	k.meanX -= dx / n
+	k.meanY -= dy / n
+	k.cxy -= dx * (y - k.meanY)
+	k.cxx -= dx * (x - k.meanX)
+	k.cyy -= dy * (y - k.meanY)
+}
+
+func (k *rollingCov[T]) value(count int) T {
+	if k.nonFinite > 0 {
+		return math.NaN[T]()
+	}
+
+	cxy, cxx, cyy := k.cxy, k.cxx, k.cyy
+	if k.runX >= k.n {
+		cxy, cxx = 0, 0
+	}
+	if k.runY >= k.n {
+		cxy, cyy = 0, 0
+	}
+
+	if k.corr {
+		den := cxx * cyy
+		if k.n < 2 || den <= 0 {
+			return math.NaN[T]()
+		}
+		return cxy / math.Sqrt(den)
+	}
+
+	if k.n <= k.ddof {
+		return math.NaN[T]()
+	}
+	return cxy / T(k.n-k.ddof)
+}
+
+// momentStat is the statistic computed from central moments.
+type momentStat int
+
+const (
+	momentVar momentStat = iota
+	momentStd
+	momentSem
+	momentSkew
+	momentKurt
+)
+
+// rollingMoments is the running mean and sums of powers of deviations from the mean
+// up to the 4th power. Values are added and removed by the one-pass update formulas
+// by Welford and Terriberry.
+// Non-finite values are counted apart, any of them makes the result NaN.
+type rollingMoments[T Float] struct {
+	stat momentStat
+	ddof int
+
+	n, nonFinite int
+
+	mean, m2, m3, m4 T
+
+	// Length of the run of equal last values, the window is constant if the run covers it.
+	last T
+	run  int
+}
+
+func (k *rollingMoments[T]) add(i int, x T) {
+	if x-x != 0 {
+		k.nonFinite++
+		return
+	}
+
+	k.run = nextRun(k.run, k.last, x)
+	k.last = x
+
+	n1 := T(k.n)
+	k.n++
+	n := T(k.n)
+
+	delta := x - k.mean
+	dn := delta / n
+	dn2 := dn * dn
+	term := delta * dn * n1
+
+	k.mean += dn
+	k.m4 += term*dn2*(n*n-3*n+3) + 6*dn2*k.m2 - 4*dn*k.m3
+	k.m3 += term*dn*(n-2) - 3*dn*k.m2
+	k.m2 += term
+}
+
+func (k *rollingMoments[T]) remove(i int, x T) {
+	if x-x != 0 {
+		k.nonFinite--
+		return
+	}
+
+	k.n--
+	if k.n == 0 {
+		// Drop accumulated rounding error of the empty window.
+		k.mean, k.m2, k.m3, k.m4 = 0, 0, 0, 0
+		k.run = 0
+		return
+	}
+
+	// Revert the update of add(x) applied to the window without x.
+	n := T(k.n + 1)
+	mean := (n*k.mean - x) / (n - 1)
+
+	delta := x - mean
+	dn := delta / n
+	dn2 := dn * dn
+	term := delta * dn * (n - 1)
+
+	k.mean = mean
+	k.m2 -= term
+	k.m3 -= term*dn*(n-2) - 3*dn*k.m2
+	k.m4 -= term*dn2*(n*n-3*n+3) + 6*dn2*k.m2 - 4*dn*k.m3
+}
+
+func (k *rollingMoments[T]) value(count int) T {
+	if k.nonFinite > 0 {
+		return math.NaN[T]()
+	}
+
+	n, m2, m3, m4 := T(k.n), k.m2, k.m3, k.m4
+	// The window is constant if the run of equal values covers it,
+	// so rounding errors of updates don't leak into its moments.
+	if k.run >= k.n {
+		m2, m3, m4 = 0, 0, 0
+	}
+	if m2 < 0 {
+		m2 = 0
+	}
+
+	switch k.stat {
+	case momentVar, momentStd, momentSem:
+		if k.n <= k.ddof {
+			return math.NaN[T]()
+		}
+		switch variance := m2 / T(k.n-k.ddof); k.stat {
+		case momentStd:
+			return math.Sqrt(variance)
+		case momentSem:
+			return math.Sqrt(variance / n)
+		default:
+			return variance
+		}
+	case momentSkew:
+		if m2 == 0 || m3 == 0 {
+			return 0
+		}
+		if k.n < 3 {
+			return math.NaN[T]()
+		}
+		return skewness(n, m2, m3)
+	case momentKurt:
+		if k.n < 4 {
+			return math.NaN[T]()
+		}
+		if m2 == 0 {
+			return 0
+		}
+		return kurtosis(n, m2, m4)
+	default:
+		panic("unknown moment statistic")
+	}
+}
+
+// nextRun returns length of the run of equal values after v follows last.
+func nextRun[T Float](run int, last, v T) int {
+	if run > 0 && v == last {
+		return run + 1
+	}
+	return 1
+}
+
+// neumaierAdd adds v to sum with the compensation of lost low-order bits.
+func neumaierAdd[T Float](sum, comp, v T) (T, T) {
+	t := sum + v
+	if abs(sum) >= abs(v) {
+		comp += (sum - t) + v
+	} else {
+		comp += (v - t) + sum
+	}
+	return t, comp
+}
+
+func abs[T Float](x T) T {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
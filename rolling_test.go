@@ -0,0 +1,606 @@
+package series
+
+import (
+	"testing"
+	"time"
+
+	"github.com/WinPooh32/series/math"
+)
+
+func TestWindow_Sum_Compensated(t *testing.T) {
+	data := MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{1e20, 1, -1e20, 3, 4, 0.1})
+	want := MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{NaN, 1e20, -1e20, -1e20, 7, 4.1})
+
+	if got := data.Rolling(2).Sum(); !got.Equals(want, EpsFp64) {
+		t.Errorf("Window.Sum() = %v, want %v", got, want)
+	}
+}
+
+func TestWindow_Sum_NonFinite(t *testing.T) {
+	inf := math.Inf[float64](1)
+
+	data := MakeDataValid(
+		1,
+		[]int64{1, 2, 3, 4, 5, 6, 7},
+		[]float64{1, inf, 2, -inf, 3, 4, 5},
+		makeBitmap(true, true, true, true, false, true, true),
+	)
+	want := MakeDataValid(
+		1,
+		[]int64{1, 2, 3, 4, 5, 6, 7},
+		[]float64{0, inf, inf, -inf, -inf, 4, 9},
+		makeBitmap(false, true, true, true, true, true, true),
+	)
+
+	got := data.Rolling(2).Sum()
+	if !got.Equals(want, EpsFp64) {
+		t.Errorf("Window.Sum() = %v, want %v", got, want)
+	}
+}
+
+// makeRollingData returns n pseudo-random values with duplicates and NaN values.
+func makeRollingData(n int) Data[float64] {
+	values := make([]float64, n)
+	index := make([]int64, len(values))
+	for i := range values {
+		index[i] = int64(i)
+		values[i] = math.Floor(math.Sin(float64(i*i)) * 20)
+		if i%7 == 3 {
+			values[i] = NaN
+		}
+	}
+	return MakeData(1, index, values)
+}
+
+func TestWindow_Mean_Apply(t *testing.T) {
+	data := makeRollingData(200)
+
+	for _, window := range []int{1, 2, 5, 17} {
+		w := data.Rolling(window)
+		if got, want := w.Sum(), w.Apply(Sum[float64]); !got.Equals(want, 1e-9) {
+			t.Errorf("Window(%d).Sum() = %v, want %v", window, got, want)
+		}
+		if got, want := w.Mean(), w.Apply(Mean[float64]); !got.Equals(want, 1e-9) {
+			t.Errorf("Window(%d).Mean() = %v, want %v", window, got, want)
+		}
+	}
+}
+
+func TestWindow_Quantile_Apply(t *testing.T) {
+	data := makeRollingData(300)
+
+	for _, window := range []int{1, 2, 5, 16} {
+		w := data.Rolling(window)
+		if got, want := w.Median(), w.Apply(func(d Data[float64]) float64 {
+			return Quantile(d, 0.5, QuantileLinear)
+		}); !got.Equals(want, EpsFp64) {
+			t.Errorf("Window(%d).Median() = %v, want %v", window, got, want)
+		}
+
+		for _, interpolation := range []QuantileInterpolation{QuantileLinear, QuantileLower, QuantileHigher, QuantileNearest, QuantileMidpoint} {
+			for _, q := range []float64{0, 0.1, 0.25, 0.5, 0.9, 1} {
+				got := w.Quantile(q, interpolation)
+				want := w.Apply(func(d Data[float64]) float64 {
+					return Quantile(d, q, interpolation)
+				})
+				if !got.Equals(want, EpsFp64) {
+					t.Errorf("Window(%d).Quantile(%v, %v) = %v, want %v", window, q, interpolation, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestWindow_Extremum_Apply(t *testing.T) {
+	data := makeRollingData(300)
+
+	for _, window := range []int{1, 2, 5, 16} {
+		w := data.Rolling(window)
+		if got, want := w.Min(), w.Apply(Min[float64]); !got.Equals(want, EpsFp64) {
+			t.Errorf("Window(%d).Min() = %v, want %v", window, got, want)
+		}
+		if got, want := w.Max(), w.Apply(Max[float64]); !got.Equals(want, EpsFp64) {
+			t.Errorf("Window(%d).Max() = %v, want %v", window, got, want)
+		}
+
+		argmin, argmax := w.Argmin(), w.Argmax()
+		for i := range data.Values() {
+			l := i - window + 1
+			if l < 0 {
+				continue
+			}
+			slice := data.Slice(l, i+1)
+			if pos := Argmin(slice); pos >= 0 && argmin.At(i) != int64(l+pos) {
+				t.Errorf("Window(%d).Argmin().At(%d) = %v, want %v", window, i, argmin.At(i), l+pos)
+			}
+			if pos := Argmax(slice); pos >= 0 && argmax.At(i) != int64(l+pos) {
+				t.Errorf("Window(%d).Argmax().At(%d) = %v, want %v", window, i, argmax.At(i), l+pos)
+			}
+		}
+	}
+}
+
+func TestWindow_Argmax(t *testing.T) {
+	index := []int64{10, 20, 30, 40, 50, 60}
+	data := MakeData(10, index, []float64{1, 3, 3, NaN, 2, 1})
+	na := makeBitmap(false, true, true, true, true, true)
+
+	tests := []struct {
+		name string
+		fn   func(Window[float64]) Int64Data
+		want Int64Data
+	}{
+		{"argmax", Window[float64].Argmax, MakeInt64DataValid(10, index, []int64{0, 1, 1, 2, 4, 4}, na)},
+		{"argmax index", Window[float64].ArgmaxIndex, MakeInt64DataValid(10, index, []int64{0, 20, 20, 30, 50, 50}, na)},
+		{"argmin", Window[float64].Argmin, MakeInt64DataValid(10, index, []int64{0, 0, 1, 2, 4, 5}, na)},
+		{"argmin index", Window[float64].ArgminIndex, MakeInt64DataValid(10, index, []int64{0, 10, 20, 30, 50, 60}, na)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fn(data.Rolling(2)); !got.Equals(tt.want) {
+				t.Errorf("Window = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWindow_Closed(t *testing.T) {
+	sec := int64(time.Second)
+	timed := MakeData(sec, []int64{0, sec, 2 * sec, 4 * sec, 7 * sec, 8 * sec}, []float64{1, 2, 3, 4, 5, 6})
+	fixed := MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{1, 2, 3, 4, 5})
+
+	tests := []struct {
+		name   string
+		window func(opts ...WindowOption) Window[float64]
+		closed WindowClosed
+		want   []float64
+	}{
+		{"duration right", func(opts ...WindowOption) Window[float64] { return timed.RollingDuration(2*time.Second, opts...) }, ClosedRight, []float64{1, 3, 5, 4, 5, 11}},
+		{"duration left", func(opts ...WindowOption) Window[float64] { return timed.RollingDuration(2*time.Second, opts...) }, ClosedLeft, []float64{NaN, 1, 3, 3, NaN, 5}},
+		{"duration both", func(opts ...WindowOption) Window[float64] { return timed.RollingDuration(2*time.Second, opts...) }, ClosedBoth, []float64{1, 3, 6, 7, 5, 11}},
+		{"duration neither", func(opts ...WindowOption) Window[float64] { return timed.RollingDuration(2*time.Second, opts...) }, ClosedNeither, []float64{NaN, 1, 2, NaN, NaN, 5}},
+		{"fixed right", func(opts ...WindowOption) Window[float64] { return fixed.Rolling(2, opts...) }, ClosedRight, []float64{NaN, 3, 5, 7, 9}},
+		{"fixed left", func(opts ...WindowOption) Window[float64] { return fixed.Rolling(2, opts...) }, ClosedLeft, []float64{NaN, NaN, 3, 5, 7}},
+		{"fixed both", func(opts ...WindowOption) Window[float64] { return fixed.Rolling(2, opts...) }, ClosedBoth, []float64{NaN, NaN, 6, 9, 12}},
+		{"fixed neither", func(opts ...WindowOption) Window[float64] { return fixed.Rolling(2, opts...) }, ClosedNeither, []float64{NaN, 1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := tt.window(WithWindowClosed(tt.closed))
+			want := MakeData(w.data.freq, w.data.index, tt.want)
+			if got := w.Sum(); !got.Equals(want, EpsFp64) {
+				t.Errorf("Window.Sum() = %v, want %v", got, want)
+			}
+			if got := w.Apply(Sum[float64]); !got.Equals(want, EpsFp64) {
+				t.Errorf("Window.Apply() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestWindow_RollingDuration_Apply(t *testing.T) {
+	data := makeRollingData(300)
+
+	// Irregular index with duplicate timestamps.
+	ts := int64(0)
+	for i := range data.index {
+		data.index[i] = ts
+		ts += int64(i%4) * int64(time.Second)
+	}
+
+	variance := func(d Data[float64]) float64 {
+		if Count(d) <= 1 {
+			return NaN
+		}
+		return Variance(d, Mean(d), 1)
+	}
+
+	for _, closed := range []WindowClosed{ClosedRight, ClosedLeft, ClosedBoth, ClosedNeither} {
+		w := data.RollingDuration(10*time.Second, WithWindowClosed(closed))
+
+		tests := []struct {
+			name string
+			got  Data[float64]
+			want Data[float64]
+		}{
+			{"sum", w.Sum(), w.Apply(Sum[float64])},
+			{"mean", w.Mean(), w.Apply(Mean[float64])},
+			{"min", w.Min(), w.Apply(Min[float64])},
+			{"max", w.Max(), w.Apply(Max[float64])},
+			{"median", w.Median(), w.Apply(func(d Data[float64]) float64 { return Quantile(d, 0.5, QuantileLinear) })},
+			{"quantile", w.Quantile(0.9, QuantileLower), w.Apply(func(d Data[float64]) float64 { return Quantile(d, 0.9, QuantileLower) })},
+			{"skew", w.Skew(w.Mean()), w.Apply(Skew[float64])},
+			{"variance", w.Variance(w.Mean(), 1), w.Apply(variance)},
+		}
+		for _, tt := range tests {
+			if !tt.got.Equals(tt.want, 1e-9) {
+				t.Errorf("closed %d: Window.%s() = %v, want %v", closed, tt.name, tt.got, tt.want)
+			}
+		}
+	}
+}
+
+func TestWindow_Options(t *testing.T) {
+	sec := int64(time.Second)
+	data := MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 2, 3, 4, 5, 6})
+	timed := MakeData(sec, []int64{0, sec, 2 * sec, 4 * sec, 7 * sec, 8 * sec}, []float64{1, 2, 3, 4, 5, 6})
+
+	tests := []struct {
+		name   string
+		window Window[float64]
+		want   Data[float64]
+	}{
+		{
+			"min periods",
+			data.Rolling(3, WithMinPeriods(1)),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{1, 3, 6, 9, 12, 15}),
+		},
+		{
+			"min periods with NaN",
+			MakeData(1, []int64{1, 2, 3, 4}, []float64{1, NaN, 3, 4}).Rolling(3, WithMinPeriods(2)),
+			MakeData(1, []int64{1, 2, 3, 4}, []float64{NaN, NaN, 4, 7}),
+		},
+		{
+			"center",
+			data.Rolling(3, WithCenter()),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{NaN, 6, 9, 12, 15, NaN}),
+		},
+		{
+			"center even",
+			data.Rolling(4, WithCenter()),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{NaN, NaN, 10, 14, 18, NaN}),
+		},
+		{
+			"center with min periods",
+			data.Rolling(3, WithCenter(), WithMinPeriods(1)),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6}, []float64{3, 6, 9, 12, 15, 11}),
+		},
+		{
+			"step",
+			data.Rolling(3, WithStep(2)),
+			MakeData(2, []int64{1, 3, 5}, []float64{NaN, 6, 12}),
+		},
+		{
+			"duration center",
+			timed.RollingDuration(4*time.Second, WithCenter()),
+			MakeData(sec, timed.index, []float64{6, 6, 9, 4, 11, 11}),
+		},
+		{
+			"duration step",
+			timed.RollingDuration(2*time.Second, WithStep(4)),
+			MakeData(4*sec, []int64{0, 7 * sec}, []float64{1, 5}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.Sum(); !got.Equals(tt.want, EpsFp64) {
+				t.Errorf("Window.Sum() = %v, want %v", got, tt.want)
+			}
+			if got := tt.window.Apply(Sum[float64]); !got.Equals(tt.want, EpsFp64) {
+				t.Errorf("Window.Apply() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWindow_Options_Apply(t *testing.T) {
+	data := makeRollingData(300)
+
+	variance := func(d Data[float64]) float64 {
+		if Count(d) <= 1 {
+			return NaN
+		}
+		return Variance(d, Mean(d), 1)
+	}
+	std := func(d Data[float64]) float64 { return math.Sqrt(variance(d)) }
+
+	options := []struct {
+		name string
+		opts []WindowOption
+	}{
+		{"min periods", []WindowOption{WithMinPeriods(3)}},
+		{"center", []WindowOption{WithCenter()}},
+		{"step", []WindowOption{WithStep(3)}},
+		{"all", []WindowOption{WithMinPeriods(2), WithCenter(), WithStep(4)}},
+	}
+	for _, opt := range options {
+		for _, window := range []int{4, 7} {
+			w := data.Rolling(window, opt.opts...)
+
+			tests := []struct {
+				name string
+				got  Data[float64]
+				want Data[float64]
+			}{
+				{"sum", w.Sum(), w.Apply(Sum[float64])},
+				{"mean", w.Mean(), w.Apply(Mean[float64])},
+				{"min", w.Min(), w.Apply(Min[float64])},
+				{"max", w.Max(), w.Apply(Max[float64])},
+				{"median", w.Median(), w.Apply(func(d Data[float64]) float64 { return Quantile(d, 0.5, QuantileLinear) })},
+				{"skew", w.Skew(w.Mean()), w.Apply(Skew[float64])},
+				{"variance", w.Variance(w.Mean(), 1), w.Apply(variance)},
+				{"std", w.Std(w.Mean(), 1), w.Apply(std)},
+			}
+			for _, tt := range tests {
+				if !tt.got.Equals(tt.want, 1e-9) {
+					t.Errorf("%s: Window(%d).%s() = %v, want %v", opt.name, window, tt.name, tt.got, tt.want)
+				}
+			}
+		}
+	}
+}
+
+func TestWindow_Cov(t *testing.T) {
+	index := []int64{1, 2, 3, 4, 5}
+	x := MakeData(1, index, []float64{1, 2, 3, 4, 5})
+	y := MakeData(1, index, []float64{2, 4, 6, 8, 10})
+	z := MakeData(1, index, []float64{5, 4, 3, 2, 2})
+
+	tests := []struct {
+		name string
+		got  Data[float64]
+		want []float64
+	}{
+		{"cov", x.Rolling(3).Cov(y, 1), []float64{NaN, NaN, 2, 2, 2}},
+		{"cov ddof 0", x.Rolling(3).Cov(y, 0), []float64{NaN, NaN, 4. / 3, 4. / 3, 4. / 3}},
+		{"corr", x.Rolling(3).Corr(y), []float64{NaN, NaN, 1, 1, 1}},
+		{"corr negative", x.Rolling(3).Corr(z), []float64{NaN, NaN, -1, -1, -0.8660254037844386}},
+		{"corr constant", z.Rolling(2).Corr(x), []float64{NaN, -1, -1, -1, NaN}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := MakeData(1, index, tt.want)
+			if !tt.got.Equals(want, 1e-9) {
+				t.Errorf("Window = %v, want %v", tt.got, want)
+			}
+		})
+	}
+}
+
+func TestWindow_Cov_Pairwise(t *testing.T) {
+	x := makeRollingData(300)
+	y := makeRollingData(303).Slice(3, 303).Clone()
+	y.index = x.index
+
+	// pairs returns values of both series where both are valid.
+	pairs := func(l, r int) (xs, ys []float64) {
+		for i := l; i < r; i++ {
+			if !x.isNA(i) && !y.isNA(i) {
+				xs = append(xs, x.values[i])
+				ys = append(ys, y.values[i])
+			}
+		}
+		return xs, ys
+	}
+	mean := func(v []float64) (m float64) {
+		for _, v := range v {
+			m += v
+		}
+		return m / float64(len(v))
+	}
+
+	for _, window := range []int{2, 5, 16} {
+		w := x.Rolling(window, WithMinPeriods(2))
+		cov, corr := w.Cov(y, 1), w.Corr(y)
+
+		for i := range x.values {
+			l := i - window + 1
+			if l < 0 {
+				l = 0
+			}
+			xs, ys := pairs(l, i+1)
+
+			wantCov, wantCorr := NaN, NaN
+			if len(xs) >= 2 {
+				mx, my := mean(xs), mean(ys)
+				var cxy, cxx, cyy float64
+				for j := range xs {
+					cxy += (xs[j] - mx) * (ys[j] - my)
+					cxx += (xs[j] - mx) * (xs[j] - mx)
+					cyy += (ys[j] - my) * (ys[j] - my)
+				}
+				wantCov = cxy / float64(len(xs)-1)
+				if cxx*cyy > 0 {
+					wantCorr = cxy / math.Sqrt(cxx*cyy)
+				}
+			}
+
+			if got := cov.values[i]; !fpEq(got, wantCov, 1e-9) && !(math.IsNaN(got) && math.IsNaN(wantCov)) {
+				t.Errorf("Window(%d).Cov().values[%d] = %v, want %v", window, i, got, wantCov)
+			}
+			if got := corr.values[i]; !fpEq(got, wantCorr, 1e-9) && !(math.IsNaN(got) && math.IsNaN(wantCorr)) {
+				t.Errorf("Window(%d).Corr().values[%d] = %v, want %v", window, i, got, wantCorr)
+			}
+		}
+	}
+}
+
+func TestWindow_Statistics(t *testing.T) {
+	index := []int64{1, 2, 3, 4, 5, 6}
+	data := MakeData(1, index, []float64{1, 3, 2, 2, NaN, 5})
+	w := data.Rolling(3)
+
+	tests := []struct {
+		name string
+		got  Data[float64]
+		want []float64
+	}{
+		{"count", w.Count(), []float64{NaN, NaN, 3, 3, 2, 2}},
+		{"rank average", w.Rank(RankAverage, false), []float64{NaN, NaN, 2, 1.5, NaN, 2}},
+		{"rank min", w.Rank(RankMin, false), []float64{NaN, NaN, 2, 1, NaN, 2}},
+		{"rank max", w.Rank(RankMax, false), []float64{NaN, NaN, 2, 2, NaN, 2}},
+		{"rank pct", w.Rank(RankAverage, true), []float64{NaN, NaN, 2. / 3, 0.5, NaN, 1}},
+		{"zscore", w.Zscore(1), []float64{NaN, NaN, 0, -math.Sqrt(1. / 3), NaN, math.Sqrt(0.5)}},
+		{"zscore constant", MakeData(1, index, []float64{1, 1, 1, 1, 1, 2}).Rolling(3).Zscore(1), []float64{NaN, NaN, NaN, NaN, NaN, math.Sqrt(4. / 3)}},
+		{"rank center", data.Rolling(3, WithCenter()).Rank(RankAverage, false), []float64{NaN, 3, 1.5, 1.5, NaN, NaN}},
+		{"rank closed left", data.Rolling(3, WithWindowClosed(ClosedLeft)).Rank(RankAverage, false), []float64{NaN, NaN, NaN, 2.5, NaN, 3}},
+		{"zscore center", data.Rolling(3, WithCenter()).Zscore(1), []float64{NaN, 1, -math.Sqrt(1. / 3), NaN, NaN, NaN}},
+		{"zscore closed left", data.Rolling(3, WithWindowClosed(ClosedLeft)).Zscore(1), []float64{NaN, NaN, NaN, 0, NaN, NaN}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := MakeData(1, index, tt.want)
+			if !tt.got.Equals(want, 1e-9) {
+				t.Errorf("Window = %v, want %v", tt.got, want)
+			}
+		})
+	}
+}
+
+func TestWindow_Statistics_Apply(t *testing.T) {
+	data := makeRollingData(300)
+
+	sem := func(d Data[float64]) float64 {
+		if Count(d) <= 1 {
+			return NaN
+		}
+		return Sem(d, 1)
+	}
+
+	for _, opts := range [][]WindowOption{nil, {WithMinPeriods(2), WithCenter(), WithStep(3)}} {
+		for _, window := range []int{4, 9} {
+			w := data.Rolling(window, opts...)
+
+			tests := []struct {
+				name string
+				got  Data[float64]
+				want Data[float64]
+			}{
+				{"kurt", w.Kurt(), w.Apply(Kurt[float64])},
+				{"sem", w.Sem(1), w.Apply(sem)},
+				{"count", w.Count(), w.Apply(Count[float64])},
+			}
+			for _, tt := range tests {
+				if !tt.got.Equals(tt.want, 1e-9) {
+					t.Errorf("Window(%d).%s() = %v, want %v", window, tt.name, tt.got, tt.want)
+				}
+			}
+		}
+	}
+}
+
+func TestWindow_Var(t *testing.T) {
+	index := []int64{1, 2, 3, 4, 5}
+	data := MakeData(1, index, []float64{1, 2, NaN, 4, 4})
+
+	tests := []struct {
+		name string
+		got  Data[float64]
+		want []float64
+	}{
+		{"ddof 1", data.Rolling(2, WithMinPeriods(1)).Var(1), []float64{NaN, 0.5, NaN, NaN, 0}},
+		{"ddof 0", data.Rolling(2, WithMinPeriods(1)).Var(0), []float64{0, 0.25, 0, 0, 0}},
+		{"ddof 2", data.Rolling(3, WithMinPeriods(1)).Var(2), []float64{NaN, NaN, NaN, NaN, NaN}},
+		{"std", data.Rolling(3).StdDev(1), []float64{NaN, NaN, math.Sqrt(0.5), math.Sqrt(2.), 0}},
+		{"skew", data.Rolling(4).Skew(Data[float64]{}), []float64{NaN, NaN, NaN, 0.9352195295828237, -1.7320508075688772}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := MakeData(1, index, tt.want)
+			if !tt.got.Equals(want, 1e-9) {
+				t.Errorf("Window = %v, want %v", tt.got, want)
+			}
+		})
+	}
+}
+
+func TestWindow_Var_Stable(t *testing.T) {
+	// Large offset with small deviations loses precision in the naive sum of squares.
+	const n = 100000
+
+	values := make([]float64, n)
+	index := make([]int64, n)
+	for i := range values {
+		index[i] = int64(i)
+		values[i] = 1e9 + float64(i%10)
+	}
+	data := MakeData(1, index, values)
+
+	// Window of 10 values holds every deviation once: var = 55/6 (ddof 1).
+	got := data.Rolling(10).Var(1)
+	for i := 9; i < n; i += 997 {
+		if !fpEq(got.values[i], 55./6, 1e-6) {
+			t.Fatalf("Window.Var().values[%d] = %v, want %v", i, got.values[i], 55./6)
+		}
+	}
+}
+
+func TestWindow_SmallScale(t *testing.T) {
+	// Variance of values scaled by 1e-9 is far below the machine epsilon.
+	// Statistics are compared with two-pass ones or with ones of unscaled values.
+	data := makeRollingData(200)
+	small := scaleData(data, 1e-9)
+
+	variance := func(d Data[float64]) float64 {
+		if Count(d) <= 1 {
+			return NaN
+		}
+		return Var(d, 1)
+	}
+	std := func(d Data[float64]) float64 {
+		return math.Sqrt(variance(d))
+	}
+
+	for _, window := range []int{2, 9} {
+		w, ws, es := data.Rolling(window), small.Rolling(window), small.Expanding(window)
+
+		tests := []struct {
+			name  string
+			got   Data[float64]
+			want  Data[float64]
+			scale float64
+		}{
+			{"rolling var", ws.Var(1), ws.Apply(variance), 1e18},
+			{"rolling stddev", ws.StdDev(1), ws.Apply(std), 1e9},
+			{"rolling sem", ws.Sem(1), scaleData(w.Sem(1), 1e-9), 1e9},
+			{"rolling zscore", ws.Zscore(1), w.Zscore(1), 1},
+			{"rolling kurt", ws.Kurt(), w.Kurt(), 1},
+			{"expanding var", es.Var(1), es.Apply(variance), 1e18},
+			{"expanding std", es.Std(1), es.Apply(std), 1e9},
+			{"expanding kurt", es.Kurt(), es.Apply(Kurt[float64]), 1},
+		}
+		for _, tt := range tests {
+			if got, want := scaleData(tt.got, tt.scale), scaleData(tt.want, tt.scale); !got.Equals(want, 1e-6) {
+				t.Errorf("window %d: %s = %v, want %v", window, tt.name, got, want)
+			}
+		}
+	}
+
+	data32 := MakeData(1, []int64{1, 2, 3}, []float32{1e-4, 2e-4, 3e-4})
+	if got := data32.Rolling(3).Var(1).At(-1); !fpEq(got, 1e-8, 1e-10) {
+		t.Errorf("Window.Var() = %v, want %v", got, 1e-8)
+	}
+}
+
+func BenchmarkWindow_Median(b *testing.B) {
+	data := makeRollingData(100000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data.Rolling(1000).Median()
+	}
+}
+
+func BenchmarkWindow_Sum(b *testing.B) {
+	values := make([]float64, 100000)
+	index := make([]int64, len(values))
+	for i := range values {
+		index[i] = int64(i)
+		values[i] = float64(i % 100)
+	}
+	data := MakeData(1, index, values)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data.Rolling(1000).Sum()
+	}
+}
+
+// scaleData returns copy of data with values multiplied by k.
+func scaleData[T Float](d Data[T], k T) Data[T] {
+	c := d.Clone()
+	for i := range c.values {
+		c.values[i] *= k
+	}
+	return c
+}
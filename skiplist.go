@@ -0,0 +1,216 @@
+package series
+
+// skiplistMaxLevel limits height of skiplist towers, enough for 2^32 values.
+const skiplistMaxLevel = 32
+
+// skiplist is the indexable sorted multiset of values.
+// Insertion, removal and access by rank take O(log n) expected time.
+type skiplist[T Float] struct {
+	nodes []skipnode[T]
+	free  []int32
+	level int
+	len   int
+	seed  uint64
+}
+
+// skipnode is the tower of links, width is the count of values skipped by the link.
+// The zero node is the head of the list.
+type skipnode[T Float] struct {
+	value T
+	next  []int32
+	width []int
+}
+
+func makeSkiplist[T Float](capacity int) skiplist[T] {
+	level := 1
+	for n := 2; n < capacity && level < skiplistMaxLevel; n *= 2 {
+		level++
+	}
+
+	nodes := make([]skipnode[T], 1, capacity+1)
+	nodes[0] = skipnode[T]{
+		next:  make([]int32, level),
+		width: make([]int, level),
+	}
+
+	return skiplist[T]{
+		nodes: nodes,
+		level: level,
+		seed:  0x9E3779B97F4A7C15,
+	}
+}
+
+// Len returns count of values.
+func (s *skiplist[T]) Len() int {
+	return s.len
+}
+
+// height returns random height of the new tower with geometric distribution.
+func (s *skiplist[T]) height() int {
+	// xorshift64.
+	s.seed ^= s.seed << 13
+	s.seed ^= s.seed >> 7
+	s.seed ^= s.seed << 17
+
+	h := 1
+	for r := s.seed; h < s.level && r&1 == 1; r >>= 1 {
+		h++
+	}
+	return h
+}
+
+func (s *skiplist[T]) alloc(value T, height int) int32 {
+	if n := len(s.free); n > 0 {
+		id := s.free[n-1]
+		s.free = s.free[:n-1]
+
+		node := &s.nodes[id]
+		node.value = value
+		node.next = node.next[:height]
+		node.width = node.width[:height]
+		return id
+	}
+
+	s.nodes = append(s.nodes, skipnode[T]{
+		value: value,
+		next:  make([]int32, height, s.level),
+		width: make([]int, height, s.level),
+	})
+	return int32(len(s.nodes) - 1)
+}
+
+// Insert adds value, equal values are kept in insertion order.
+func (s *skiplist[T]) Insert(value T) {
+	var (
+		prev [skiplistMaxLevel]int32
+		rank [skiplistMaxLevel]int
+	)
+
+	var (
+		cur = int32(0)
+		pos = 0
+	)
+	for lvl := s.level - 1; lvl >= 0; lvl-- {
+		for {
+			next := s.nodes[cur].next[lvl]
+			if next == 0 || s.nodes[next].value > value {
+				break
+			}
+			pos += s.nodes[cur].width[lvl]
+			cur = next
+		}
+		prev[lvl] = cur
+		rank[lvl] = pos
+	}
+
+	height := s.height()
+	id := s.alloc(value, height)
+
+	for lvl := 0; lvl < s.level; lvl++ {
+		p := &s.nodes[prev[lvl]]
+		if lvl < height {
+			// The new value takes place pos+1 in the list.
+			skipped := pos - rank[lvl]
+			node := &s.nodes[id]
+			node.next[lvl] = p.next[lvl]
+			node.width[lvl] = p.width[lvl] - skipped
+			p.next[lvl] = id
+			p.width[lvl] = skipped + 1
+		} else {
+			p.width[lvl]++
+		}
+	}
+
+	s.len++
+}
+
+// Remove deletes one of values equal to value.
+// It reports whether the value was found.
+func (s *skiplist[T]) Remove(value T) bool {
+	var prev [skiplistMaxLevel]int32
+
+	cur := int32(0)
+	for lvl := s.level - 1; lvl >= 0; lvl-- {
+		for {
+			next := s.nodes[cur].next[lvl]
+			if next == 0 || s.nodes[next].value >= value {
+				break
+			}
+			cur = next
+		}
+		prev[lvl] = cur
+	}
+
+	id := s.nodes[cur].next[0]
+	if id == 0 || s.nodes[id].value != value {
+		return false
+	}
+
+	node := &s.nodes[id]
+	for lvl := 0; lvl < s.level; lvl++ {
+		p := &s.nodes[prev[lvl]]
+		if lvl < len(node.next) && p.next[lvl] == id {
+			p.next[lvl] = node.next[lvl]
+			p.width[lvl] += node.width[lvl] - 1
+		} else {
+			p.width[lvl]--
+		}
+	}
+
+	s.free = append(s.free, id)
+	s.len--
+
+	return true
+}
+
+// Rank returns counts of values less than value and equal to value.
+func (s *skiplist[T]) Rank(value T) (less, equal int) {
+	less = s.count(value, false)
+	return less, s.count(value, true) - less
+}
+
+// count returns count of values less than value or less than or equal to value.
+func (s *skiplist[T]) count(value T, orEqual bool) int {
+	var (
+		cur = int32(0)
+		pos = 0
+	)
+	for lvl := s.level - 1; lvl >= 0; lvl-- {
+		for {
+			next := s.nodes[cur].next[lvl]
+			if next == 0 {
+				break
+			}
+			if v := s.nodes[next].value; v > value || (v == value && !orEqual) {
+				break
+			}
+			pos += s.nodes[cur].width[lvl]
+			cur = next
+		}
+	}
+	return pos
+}
+
+// At returns value of rank i counting from zero.
+func (s *skiplist[T]) At(i int) T {
+	if i < 0 || i >= s.len {
+		panic("skiplist rank is out of range")
+	}
+
+	var (
+		cur = int32(0)
+		pos = -1
+	)
+	for lvl := s.level - 1; lvl >= 0; lvl-- {
+		for {
+			next := s.nodes[cur].next[lvl]
+			if next == 0 || pos+s.nodes[cur].width[lvl] > i {
+				break
+			}
+			pos += s.nodes[cur].width[lvl]
+			cur = next
+		}
+	}
+
+	return s.nodes[cur].value
+}
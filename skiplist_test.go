@@ -0,0 +1,62 @@
+package series
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestSkiplist(t *testing.T) {
+	var (
+		list = makeSkiplist[float64](16)
+		want []float64
+	)
+
+	check := func() {
+		t.Helper()
+		if list.Len() != len(want) {
+			t.Fatalf("skiplist.Len() = %d, want %d", list.Len(), len(want))
+		}
+		for i, v := range want {
+			if got := list.At(i); got != v {
+				t.Fatalf("skiplist.At(%d) = %v, want %v", i, got, v)
+			}
+		}
+		for i, v := range want {
+			less := sort.SearchFloat64s(want, v)
+			equal := sort.SearchFloat64s(want, v+0.5) - less
+			if i == less {
+				if l, e := list.Rank(v); l != less || e != equal {
+					t.Fatalf("skiplist.Rank(%v) = %d, %d, want %d, %d", v, l, e, less, equal)
+				}
+			}
+		}
+	}
+
+	// Deterministic sequence of values with duplicates.
+	seed := uint32(1)
+	next := func() float64 {
+		seed = seed*1103515245 + 12345
+		return float64(seed>>16) / 4096
+	}
+
+	for i := 0; i < 500; i++ {
+		v := float64(int(next()))
+		list.Insert(v)
+		want = append(want, v)
+		sort.Float64s(want)
+		check()
+
+		if i%3 == 2 {
+			j := int(next()*1000) % len(want)
+			if !list.Remove(want[j]) {
+				t.Fatalf("skiplist.Remove(%v) = false, want true", want[j])
+			}
+			want = append(want[:j], want[j+1:]...)
+			check()
+		}
+	}
+
+	if list.Remove(-1) {
+		t.Errorf("skiplist.Remove(-1) = true, want false")
+	}
+}
@@ -0,0 +1,300 @@
+//go:build series_avx2
+
+package vek
+
+import (
+	"github.com/viterin/vek"
+	"github.com/viterin/vek/vek32"
+
+	"github.com/WinPooh32/series/math"
+)
+
+func AddScalar[T math.Float](y1 []T, v T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.AddNumber_Inplace(y1, float32(v))
+	case []float64:
+		vek.AddNumber_Inplace(y1, float64(v))
+	}
+}
+
+func SubScalar[T math.Float](y1 []T, v T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.SubNumber_Inplace(y1, float32(v))
+	case []float64:
+		vek.SubNumber_Inplace(y1, float64(v))
+	}
+}
+
+func MulScalar[T math.Float](y1 []T, v T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.MulNumber_Inplace(y1, float32(v))
+	case []float64:
+		vek.MulNumber_Inplace(y1, float64(v))
+	}
+}
+
+func DivScalar[T math.Float](y1 []T, v T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.DivNumber_Inplace(y1, float32(v))
+	case []float64:
+		vek.DivNumber_Inplace(y1, float64(v))
+	}
+}
+
+func Add[T math.Float](y1 []T, y2 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Add_Inplace(y1, any(y2).([]float32))
+	case []float64:
+		vek.Add_Inplace(y1, any(y2).([]float64))
+	}
+}
+
+func Sub[T math.Float](y1 []T, y2 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Sub_Inplace(y1, any(y2).([]float32))
+	case []float64:
+		vek.Sub_Inplace(y1, any(y2).([]float64))
+	}
+}
+
+func Mul[T math.Float](y1 []T, y2 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Mul_Inplace(y1, any(y2).([]float32))
+	case []float64:
+		vek.Mul_Inplace(y1, any(y2).([]float64))
+	}
+}
+
+func Div[T math.Float](y1 []T, y2 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Div_Inplace(y1, any(y2).([]float32))
+	case []float64:
+		vek.Div_Inplace(y1, any(y2).([]float64))
+	}
+}
+
+func Minimum[T math.Float](y1 []T, y2 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Minimum_Inplace(y1, any(y2).([]float32))
+	case []float64:
+		vek.Minimum_Inplace(y1, any(y2).([]float64))
+	}
+}
+
+func Maximum[T math.Float](y1 []T, y2 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Maximum_Inplace(y1, any(y2).([]float32))
+	case []float64:
+		vek.Maximum_Inplace(y1, any(y2).([]float64))
+	}
+}
+
+func Pow[T math.Float](y1 []T, y2 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Pow_Inplace(y1, any(y2).([]float32))
+	case []float64:
+		vek.Pow_Inplace(y1, any(y2).([]float64))
+	}
+}
+
+func Sqrt[T math.Float](y1 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Sqrt_Inplace(y1)
+	case []float64:
+		vek.Sqrt_Inplace(y1)
+	}
+}
+
+func Abs[T math.Float](y1 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Abs_Inplace(y1)
+	case []float64:
+		vek.Abs_Inplace(y1)
+	}
+}
+
+func Round[T math.Float](y1 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Round_Inplace(y1)
+	case []float64:
+		vek.Round_Inplace(y1)
+	}
+}
+
+func Ceil[T math.Float](y1 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Ceil_Inplace(y1)
+	case []float64:
+		vek.Ceil_Inplace(y1)
+	}
+}
+
+func Floor[T math.Float](y1 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Floor_Inplace(y1)
+	case []float64:
+		vek.Floor_Inplace(y1)
+	}
+}
+
+func Min[T math.Float](y1 []T) T {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		return T(vek32.Min(y1))
+	default:
+		return T(vek.Min(y1.([]float64)))
+	}
+}
+
+func Max[T math.Float](y1 []T) T {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		return T(vek32.Max(y1))
+	default:
+		return T(vek.Max(y1.([]float64)))
+	}
+}
+
+func Dot[T math.Float](y1 []T, y2 []T) T {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		return T(vek32.Dot(y1, any(y2).([]float32)))
+	default:
+		return T(vek.Dot(y1.([]float64), any(y2).([]float64)))
+	}
+}
+
+func ArgMin[T math.Float](y1 []T) int {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		return vek32.ArgMin(y1)
+	default:
+		return vek.ArgMin(y1.([]float64))
+	}
+}
+
+func ArgMax[T math.Float](y1 []T) int {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		return vek32.ArgMax(y1)
+	default:
+		return vek.ArgMax(y1.([]float64))
+	}
+}
+
+func Repeat[T math.Float](dst []T, v T) {
+	switch dst := any(dst).(type) {
+	case []float32:
+		vek32.Repeat_Into(dst, float32(v), len(dst))
+	case []float64:
+		vek.Repeat_Into(dst, float64(v), len(dst))
+	}
+}
+
+func ToInt64[T math.Float](dst []int64, y1 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.ToInt64_Into(dst, y1)
+	case []float64:
+		vek.ToInt64_Into(dst, y1)
+	}
+}
+
+func ToInt32[T math.Float](dst []int32, y1 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.ToInt32_Into(dst, y1)
+	case []float64:
+		vek.ToInt32_Into(dst, y1)
+	}
+}
+
+func ToFloat64[T math.Float](dst []float64, y1 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.ToFloat64_Into(dst, y1)
+	case []float64:
+		copy(dst, y1)
+	}
+}
+
+func ToFloat32[T math.Float](dst []float32, y1 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		copy(dst, y1)
+	case []float64:
+		vek.ToFloat32_Into(dst, y1)
+	}
+}
+
+// float32 exclusive.
+
+func Exp[T math.Float](y1 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Exp_Inplace(y1)
+	default:
+		panic("not implemented!")
+	}
+}
+
+func Cos[T math.Float](y1 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Cos_Inplace(y1)
+	default:
+		panic("not implemented!")
+	}
+}
+
+func Sin[T math.Float](y1 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Sin_Inplace(y1)
+	default:
+		panic("not implemented!")
+	}
+}
+
+func Log[T math.Float](y1 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Log_Inplace(y1)
+	default:
+		panic("not implemented!")
+	}
+}
+
+func Log2[T math.Float](y1 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Log2_Inplace(y1)
+	default:
+		panic("not implemented!")
+	}
+}
+
+func Log10[T math.Float](y1 []T) {
+	switch y1 := any(y1).(type) {
+	case []float32:
+		vek32.Log10_Inplace(y1)
+	default:
+		panic("not implemented!")
+	}
+}
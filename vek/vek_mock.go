@@ -0,0 +1,45 @@
+//go:build !series_avx2
+
+package vek
+
+import "github.com/WinPooh32/series/math"
+
+func AddScalar[T math.Float](y1 []T, v T) { panic("unreachable!") }
+func SubScalar[T math.Float](y1 []T, v T) { panic("unreachable!") }
+func MulScalar[T math.Float](y1 []T, v T) { panic("unreachable!") }
+func DivScalar[T math.Float](y1 []T, v T) { panic("unreachable!") }
+
+func Add[T math.Float](y1 []T, y2 []T)     { panic("unreachable!") }
+func Sub[T math.Float](y1 []T, y2 []T)     { panic("unreachable!") }
+func Mul[T math.Float](y1 []T, y2 []T)     { panic("unreachable!") }
+func Div[T math.Float](y1 []T, y2 []T)     { panic("unreachable!") }
+func Minimum[T math.Float](y1 []T, y2 []T) { panic("unreachable!") }
+func Maximum[T math.Float](y1 []T, y2 []T) { panic("unreachable!") }
+func Pow[T math.Float](y1 []T, y2 []T)     { panic("unreachable!") }
+
+func Sqrt[T math.Float](y1 []T)  { panic("unreachable!") }
+func Abs[T math.Float](y1 []T)   { panic("unreachable!") }
+func Round[T math.Float](y1 []T) { panic("unreachable!") }
+func Ceil[T math.Float](y1 []T)  { panic("unreachable!") }
+func Floor[T math.Float](y1 []T) { panic("unreachable!") }
+
+func Min[T math.Float](y1 []T) T { panic("unreachable!") }
+func Max[T math.Float](y1 []T) T { panic("unreachable!") }
+
+func Dot[T math.Float](y1 []T, y2 []T) T { panic("unreachable!") }
+
+func ArgMin[T math.Float](y1 []T) int { panic("unreachable!") }
+func ArgMax[T math.Float](y1 []T) int { panic("unreachable!") }
+
+func Repeat[T math.Float](dst []T, v T)             { panic("unreachable!") }
+func ToInt64[T math.Float](dst []int64, y1 []T)     { panic("unreachable!") }
+func ToInt32[T math.Float](dst []int32, y1 []T)     { panic("unreachable!") }
+func ToFloat64[T math.Float](dst []float64, y1 []T) { panic("unreachable!") }
+func ToFloat32[T math.Float](dst []float32, y1 []T) { panic("unreachable!") }
+
+func Exp[T math.Float](y1 []T)   { panic("unreachable!") }
+func Cos[T math.Float](y1 []T)   { panic("unreachable!") }
+func Sin[T math.Float](y1 []T)   { panic("unreachable!") }
+func Log[T math.Float](y1 []T)   { panic("unreachable!") }
+func Log2[T math.Float](y1 []T)  { panic("unreachable!") }
+func Log10[T math.Float](y1 []T) { panic("unreachable!") }
@@ -0,0 +1,249 @@
+package series
+
+import (
+	"github.com/WinPooh32/series/math"
+)
+
+// WindowKind is the shape of window weights.
+type WindowKind int
+
+const (
+	// WindowTriangular is the triangular window.
+	WindowTriangular WindowKind = iota
+	// WindowHamming is the Hamming window.
+	WindowHamming
+	// WindowHann is the Hann window.
+	WindowHann
+	// WindowBlackman is the Blackman window.
+	WindowBlackman
+	// WindowGaussian is the Gaussian window, the parameter is the standard deviation.
+	WindowGaussian
+	// WindowExponential is the exponential window centered in the window,
+	// the optional parameter is the decay scale tau, 1 by default.
+	WindowExponential
+	// WindowCustom is the window of user supplied weights, parameters are the weights.
+	WindowCustom
+)
+
+// WeightedWindow provides rolling window calculations with weighted values.
+type WeightedWindow[T Float] struct {
+	window  Window[T]
+	weights []T
+}
+
+// Weighted provides rolling calculations with window weights of kind shape.
+// Weights are symmetric like scipy.signal.windows, the first weight belongs to the oldest value.
+// Only windows of fixed size can be weighted.
+func (w Window[T]) Weighted(kind WindowKind, params ...T) WeightedWindow[T] {
+	if w.dur > 0 {
+		panic("weighted window must have fixed size")
+	}
+	if w.len <= 0 {
+		panic("window size must be greater than zero")
+	}
+
+	return WeightedWindow[T]{
+		window:  w,
+		weights: windowWeights(kind, w.span(), params),
+	}
+}
+
+// Weights returns weights of window values.
+func (ww WeightedWindow[T]) Weights() []T {
+	return ww.weights
+}
+
+// Sum returns rolling weighted sum of valid values.
+// Weights are renormalized if the window has NA values,
+// so the sum is scaled by the ratio of total weight to weight of valid values.
+func (ww WeightedWindow[T]) Sum() Data[T] {
+	var total T
+	for _, wt := range ww.weights {
+		total += wt
+	}
+
+	return ww.apply(func(xs, ws []T) T {
+		var sum, norm T
+		for i, x := range xs {
+			sum += ws[i] * x
+			norm += ws[i]
+		}
+		if len(xs) == len(ww.weights) {
+			return sum
+		}
+		if norm == 0 {
+			return math.NaN[T]()
+		}
+		return sum * total / norm
+	})
+}
+
+// Mean returns rolling weighted mean of valid values.
+func (ww WeightedWindow[T]) Mean() Data[T] {
+	return ww.apply(weightedMean[T])
+}
+
+// Var returns rolling weighted variance of valid values.
+// Ddof - Delta Degrees of Freedom. The divisor used in calculations is N - ddof,
+// where N represents the number of valid values.
+func (ww WeightedWindow[T]) Var(ddof int) Data[T] {
+	if ddof < 0 {
+		panic("ddof must be positive value!")
+	}
+	return ww.apply(func(xs, ws []T) T {
+		return weightedVar(xs, ws, ddof)
+	})
+}
+
+// Std returns rolling weighted standard deviation of valid values.
+// Ddof - Delta Degrees of Freedom. The divisor used in calculations is N - ddof,
+// where N represents the number of valid values.
+func (ww WeightedWindow[T]) Std(ddof int) Data[T] {
+	if ddof < 0 {
+		panic("ddof must be positive value!")
+	}
+	return ww.apply(func(xs, ws []T) T {
+		return math.Sqrt(weightedVar(xs, ws, ddof))
+	})
+}
+
+// apply applies agg to valid values of every window and their weights.
+func (ww WeightedWindow[T]) apply(agg func(xs, ws []T) T) Data[T] {
+	var (
+		w      = ww.window
+		data   = w.data
+		out    = w.output()
+		values = out.values
+		step   = w.step()
+		lo, _  = w.offsets()
+
+		xs = make([]T, 0, len(ww.weights))
+		ws = make([]T, 0, len(ww.weights))
+	)
+
+	w.scan(rollingCount[T]{}, func(i, l, r, count int) {
+		if !w.ready(l, r, count) {
+			values[i/step] = math.NaN[T]()
+			return
+		}
+
+		xs, ws = xs[:0], ws[:0]
+		for p := l; p < r; p++ {
+			if data.isNA(p) {
+				continue
+			}
+			xs = append(xs, data.values[p])
+			ws = append(ws, ww.weights[p-(i-lo)])
+		}
+
+		values[i/step] = agg(xs, ws)
+	})
+
+	return out.maskedLike(data)
+}
+
+func weightedMean[T Float](xs, ws []T) T {
+	var sum, norm T
+	for i, x := range xs {
+		sum += ws[i] * x
+		norm += ws[i]
+	}
+	if norm == 0 {
+		return math.NaN[T]()
+	}
+	return sum / norm
+}
+
+// weightedVar returns variance of values weighted by reliability weights,
+// corrected by n / (n - ddof) for n values.
+func weightedVar[T Float](xs, ws []T, ddof int) T {
+	n := len(xs)
+	if n <= ddof {
+		return math.NaN[T]()
+	}
+
+	mean := weightedMean(xs, ws)
+
+	var dev, norm T
+	for i, x := range xs {
+		d := x - mean
+		dev += ws[i] * d * d
+		norm += ws[i]
+	}
+
+	return dev / norm * T(n) / T(n-ddof)
+}
+
+// windowWeights returns m weights of kind shape.
+func windowWeights[T Float](kind WindowKind, m int, params []T) []T {
+	if kind == WindowCustom {
+		if len(params) != m {
+			panic("length of weights must be equal to window size")
+		}
+		return append([]T(nil), params...)
+	}
+
+	weights := make([]T, m)
+	if m == 1 {
+		weights[0] = 1
+		return weights
+	}
+
+	var (
+		last   = float64(m - 1)
+		center = last / 2
+		shape  func(n float64) float64
+	)
+
+	switch kind {
+	case WindowTriangular:
+		// Peak is 1 for odd sizes only, like scipy.signal.windows.triang.
+		half := float64(m+1) / 2
+		if m%2 == 0 {
+			half = float64(m) / 2
+		}
+		shape = func(n float64) float64 {
+			return 1 - math.Abs(n-center)/half
+		}
+	case WindowHamming:
+		shape = func(n float64) float64 {
+			return 0.54 - 0.46*math.Cos(2*math.Pi*n/last)
+		}
+	case WindowHann:
+		shape = func(n float64) float64 {
+			return 0.5 - 0.5*math.Cos(2*math.Pi*n/last)
+		}
+	case WindowBlackman:
+		shape = func(n float64) float64 {
+			return 0.42 - 0.5*math.Cos(2*math.Pi*n/last) + 0.08*math.Cos(4*math.Pi*n/last)
+		}
+	case WindowGaussian:
+		if len(params) != 1 || params[0] <= 0 {
+			panic("gaussian window requires positive standard deviation")
+		}
+		std := float64(params[0])
+		shape = func(n float64) float64 {
+			d := n - center
+			return math.Exp(-d * d / (2 * std * std))
+		}
+	case WindowExponential:
+		tau := 1.0
+		if len(params) > 0 {
+			tau = float64(params[0])
+		}
+		if len(params) > 1 || tau <= 0 {
+			panic("exponential window requires positive decay scale")
+		}
+		shape = func(n float64) float64 {
+			return math.Exp(-math.Abs(n-center) / tau)
+		}
+	default:
+		panic("unknown window kind")
+	}
+
+	for i := range weights {
+		weights[i] = T(shape(float64(i)))
+	}
+
+	return weights
+}
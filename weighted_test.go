@@ -0,0 +1,89 @@
+package series
+
+import (
+	"testing"
+
+	"github.com/WinPooh32/series/math"
+)
+
+func TestWindowWeights(t *testing.T) {
+	e := math.Exp(-1.0)
+
+	tests := []struct {
+		name   string
+		kind   WindowKind
+		m      int
+		params []float64
+		want   []float64
+	}{
+		{"triangular odd", WindowTriangular, 5, nil, []float64{1. / 3, 2. / 3, 1, 2. / 3, 1. / 3}},
+		{"triangular even", WindowTriangular, 4, nil, []float64{0.25, 0.75, 0.75, 0.25}},
+		{"hamming", WindowHamming, 5, nil, []float64{0.08, 0.54, 1, 0.54, 0.08}},
+		{"hann", WindowHann, 5, nil, []float64{0, 0.5, 1, 0.5, 0}},
+		{"blackman", WindowBlackman, 5, nil, []float64{0, 0.34, 1, 0.34, 0}},
+		{"gaussian", WindowGaussian, 5, []float64{1}, []float64{e * e, math.Exp(-0.5), 1, math.Exp(-0.5), e * e}},
+		{"exponential", WindowExponential, 5, nil, []float64{e * e, e, 1, e, e * e}},
+		{"exponential tau", WindowExponential, 3, []float64{2}, []float64{math.Exp(-0.5), 1, math.Exp(-0.5)}},
+		{"custom", WindowCustom, 3, []float64{1, 2, 3}, []float64{1, 2, 3}},
+		{"single", WindowHann, 1, nil, []float64{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := windowWeights(tt.kind, tt.m, tt.params)
+			if len(got) != len(tt.want) {
+				t.Fatalf("windowWeights() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !fpEq(got[i], tt.want[i], 1e-12) {
+					t.Fatalf("windowWeights() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestWeightedWindow(t *testing.T) {
+	index := []int64{1, 2, 3, 4, 5}
+	data := MakeData(1, index, []float64{0, 1, 2, 3, 4})
+	withNA := MakeData(1, index, []float64{1, NaN, 3, 4, 5})
+
+	// Weight of the pandas example: rolling(2, win_type="gaussian").sum(std=3).
+	g := math.Exp(-0.25 / 18)
+
+	tests := []struct {
+		name string
+		got  Data[float64]
+		want []float64
+	}{
+		{"gaussian sum", data.Rolling(2).Weighted(WindowGaussian, 3).Sum(), []float64{NaN, g, 3 * g, 5 * g, 7 * g}},
+		{"custom sum", data.Rolling(3).Weighted(WindowCustom, 1, 2, 3).Sum(), []float64{NaN, NaN, 8, 14, 20}},
+		{"custom mean", data.Rolling(3).Weighted(WindowCustom, 1, 2, 3).Mean(), []float64{NaN, NaN, 8. / 6, 14. / 6, 20. / 6}},
+		{"renormalized sum", withNA.Rolling(3).Weighted(WindowCustom, 1, 2, 3).Sum(), []float64{NaN, NaN, 10 * 6. / 4, 18 * 6. / 5, 26}},
+		{"renormalized mean", withNA.Rolling(3).Weighted(WindowCustom, 1, 2, 3).Mean(), []float64{NaN, NaN, 2.5, 3.6, 26. / 6}},
+		{"var", data.Rolling(3).Weighted(WindowCustom, 1, 1, 2).Var(1), []float64{NaN, NaN, 0.6875 * 1.5, 0.6875 * 1.5, 0.6875 * 1.5}},
+		{"var equal weights", data.Rolling(3).Weighted(WindowCustom, 1, 1, 1).Var(1), []float64{NaN, NaN, 1, 1, 1}},
+		{"std", data.Rolling(3).Weighted(WindowCustom, 1, 1, 1).Std(0), []float64{NaN, NaN, math.Sqrt(2. / 3), math.Sqrt(2. / 3), math.Sqrt(2. / 3)}},
+		{"min periods", data.Rolling(3, WithMinPeriods(1)).Weighted(WindowCustom, 1, 2, 3).Mean(), []float64{0, 3. / 5, 8. / 6, 14. / 6, 20. / 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := MakeData(1, index, tt.want)
+			if !tt.got.Equals(want, 1e-9) {
+				t.Errorf("WeightedWindow = %v, want %v", tt.got, want)
+			}
+		})
+	}
+}
+
+func TestWeightedWindow_Equal(t *testing.T) {
+	data := makeRollingData(200)
+	w := data.Rolling(6, WithMinPeriods(2))
+	ww := w.Weighted(WindowCustom, 1, 1, 1, 1, 1, 1)
+
+	if got, want := ww.Mean(), w.Mean(); !got.Equals(want, 1e-9) {
+		t.Errorf("WeightedWindow.Mean() = %v, want %v", got, want)
+	}
+	if got, want := ww.Var(1), w.Variance(w.Mean(), 1); !got.Equals(want, 1e-9) {
+		t.Errorf("WeightedWindow.Var() = %v, want %v", got, want)
+	}
+}
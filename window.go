@@ -1,109 +1,428 @@
 package series
 
 import (
-	"sort"
+	"time"
 
 	"github.com/WinPooh32/series/math"
 )
 
-type Window struct {
+// Window provides rolling window calculations.
+// The window spans len values or, if dur is set, index values within dur duration.
+type Window[T Float] struct {
 	len  int
-	data Data
+	dur  int64
+	data Data[T]
+	opts windowOptions
 }
 
-func (w Window) Sum() Data {
-	return w.Apply(Sum)
+// WindowClosed is the set of closed sides of rolling window interval.
+type WindowClosed int
+
+const (
+	// ClosedRight excludes the first point of the window: (begin, end].
+	ClosedRight WindowClosed = iota
+	// ClosedLeft excludes the last point of the window: [begin, end).
+	ClosedLeft
+	// ClosedBoth includes both points of the window: [begin, end].
+	ClosedBoth
+	// ClosedNeither excludes both points of the window: (begin, end).
+	ClosedNeither
+)
+
+// WindowOption configures rolling window.
+type WindowOption func(opts *windowOptions)
+
+type windowOptions struct {
+	closed     WindowClosed
+	minPeriods int
+	center     bool
+	step       int
+}
+
+// WithWindowClosed sets which sides of the window interval are closed.
+// Windows are closed at the right side by default, so the current value is included.
+// For fixed windows the interval is measured in values: ClosedBoth spans len+1 values
+// and ClosedNeither spans len-1 values.
+func WithWindowClosed(closed WindowClosed) WindowOption {
+	if closed < ClosedRight || closed > ClosedNeither {
+		panic("closed must be ClosedRight, ClosedLeft, ClosedBoth or ClosedNeither")
+	}
+	return func(opts *windowOptions) {
+		opts.closed = closed
+	}
+}
+
+// WithMinPeriods sets the minimum count of valid values required to aggregate the window.
+// By default fixed windows must be complete and have a valid value,
+// time windows must have a valid value.
+func WithMinPeriods(n int) WindowOption {
+	if n <= 0 {
+		panic("min periods must be greater than zero")
+	}
+	return func(opts *windowOptions) {
+		opts.minPeriods = n
+	}
+}
+
+// WithCenter places the current value at the center of the window instead of the end.
+// The extra value of even fixed windows is taken from the start like pandas,
+// so the window of 4 values spans values i-2..i+1.
+func WithCenter() WindowOption {
+	return func(opts *windowOptions) {
+		opts.center = true
+	}
+}
+
+// WithStep aggregates every step-th window only, starting from the first value.
+// Results are labeled by index values of those windows.
+func WithStep(step int) WindowOption {
+	if step <= 0 {
+		panic("step must be greater than zero")
+	}
+	return func(opts *windowOptions) {
+		opts.step = step
+	}
+}
+
+func makeWindowOptions(opts []WindowOption) windowOptions {
+	var o windowOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// RollingDuration provides rolling window calculations over values
+// within dur duration of index values.
+// Index must be sorted in ascending order.
+func (d Data[T]) RollingDuration(dur time.Duration, opts ...WindowOption) Window[T] {
+	if dur <= 0 {
+		panic("window duration must be greater than zero")
+	}
+	return Window[T]{
+		dur:  int64(dur),
+		data: d,
+		opts: makeWindowOptions(opts),
+	}
 }
 
-func (w Window) Mean() Data {
-	return w.Apply(Mean)
+// Sum returns rolling sum of valid values in O(n) time.
+func (w Window[T]) Sum() Data[T] {
+	return w.roll(&rollingSum[T]{})
 }
 
-func (w Window) Min() Data {
-	return w.Apply(Min)
+// Mean returns rolling mean of valid values in O(n) time.
+func (w Window[T]) Mean() Data[T] {
+	return w.roll(&rollingMean[T]{})
 }
 
-func (w Window) Max() Data {
-	return w.Apply(Max)
+// Min returns rolling minimum of valid values in O(n) time.
+func (w Window[T]) Min() Data[T] {
+	return w.roll(&rollingExtremum[T]{})
 }
 
-func (w Window) Skew(ma Data) Data {
-	return w.Apply(Skew)
+// Max returns rolling maximum of valid values in O(n) time.
+func (w Window[T]) Max() Data[T] {
+	return w.roll(&rollingExtremum[T]{max: true})
 }
 
-func (w Window) Median() Data {
-	return w.applyMedian()
+// Argmin returns offsets of rolling minimums in series data.
+// If the minimum is achieved in multiple locations, the first row position is returned.
+// Offsets of not ready windows are missing.
+func (w Window[T]) Argmin() Int64Data {
+	return w.rollArg(&rollingExtremum[T]{}, false)
 }
 
-func (w Window) Variance(ma Data, ddof int) Data {
-	return w.applyVar(Variance, ma, ddof)
+// Argmax returns offsets of rolling maximums in series data.
+// If the maximum is achieved in multiple locations, the first row position is returned.
+// Offsets of not ready windows are missing.
+func (w Window[T]) Argmax() Int64Data {
+	return w.rollArg(&rollingExtremum[T]{max: true}, false)
 }
 
-func (w Window) Std(ma Data, ddof int) Data {
-	return w.applyVar(Std, ma, ddof)
+// ArgminIndex returns index values of rolling minimums,
+// it is the same as Argmin but offsets are replaced by index values.
+func (w Window[T]) ArgminIndex() Int64Data {
+	return w.rollArg(&rollingExtremum[T]{}, true)
 }
 
-func (w Window) Apply(agg AggregateFunc) Data {
+// ArgmaxIndex returns index values of rolling maximums,
+// it is the same as Argmax but offsets are replaced by index values.
+func (w Window[T]) ArgmaxIndex() Int64Data {
+	return w.rollArg(&rollingExtremum[T]{max: true}, true)
+}
+
+func (w Window[T]) rollArg(kernel *rollingExtremum[T], index bool) Int64Data {
 	var (
-		clone  = w.data.Clone()
-		values = clone.Values()
-		period = w.len
+		data   = w.data
+		out    = w.output()
+		step   = w.step()
+		values = make([]int64, len(out.values))
+		valid  = MakeBitmap(len(out.values), true)
 	)
 
-	for i := 0; i < w.len-1; i++ {
-		values[i] = math.NaN()
+	w.scan(kernel, func(i, l, r, count int) {
+		pos := kernel.arg()
+		switch {
+		case !w.ready(l, r, count) || pos < 0:
+			valid.Set(i/step, false)
+		case index:
+			values[i/step] = data.index[pos]
+		default:
+			values[i/step] = int64(pos)
+		}
+	})
+
+	return MakeInt64DataValid(out.freq, out.index, values, valid)
+}
+
+// Skew returns rolling unbiased skewness of valid values in O(n) time.
+// The mean is tracked in the same pass, ma is ignored and kept for compatibility.
+func (w Window[T]) Skew(ma Data[T]) Data[T] {
+	return w.roll(&rollingMoments[T]{stat: momentSkew})
+}
+
+// Median returns rolling median of valid values in O(n log w) time.
+func (w Window[T]) Median() Data[T] {
+	return w.Quantile(0.5, QuantileLinear)
+}
+
+// Quantile returns rolling q-th quantile of valid values in O(n log w) time.
+func (w Window[T]) Quantile(q T, interpolation QuantileInterpolation) Data[T] {
+	mustBeQuantile(q)
+
+	return w.roll(&rollingQuantile[T]{
+		rollingSorted: rollingSorted[T]{list: makeSkiplist[T](w.capacity())},
+		q:             q,
+		interpolation: interpolation,
+	})
+}
+
+// Variance returns rolling variance of valid values around means of ma,
+// ma must have the mean for every window result.
+// Every window is rescanned, so it costs O(n*w) time.
+//
+// Deprecated: Use Var, which tracks the mean itself in a single pass.
+func (w Window[T]) Variance(ma Data[T], ddof int) Data[T] {
+	return w.applyVar(Variance[T], ma, ddof)
+}
+
+// Std returns rolling standard deviation of valid values around means of ma,
+// ma must have the mean for every window result.
+// Every window is rescanned, so it costs O(n*w) time.
+//
+// Deprecated: Use StdDev, which tracks the mean itself in a single pass.
+func (w Window[T]) Std(ma Data[T], ddof int) Data[T] {
+	return w.applyVar(Std[T], ma, ddof)
+}
+
+// Var returns rolling variance of valid values in O(n) time.
+// The mean and squared deviations are updated online by Welford's method.
+// Ddof - Delta Degrees of Freedom. The divisor used in calculations is N - ddof,
+// where N represents the number of valid values. Windows with N <= ddof are NaN,
+// windows of equal values have zero variance.
+func (w Window[T]) Var(ddof int) Data[T] {
+	if ddof < 0 {
+		panic("ddof must be positive value!")
+	}
+	return w.roll(&rollingMoments[T]{stat: momentVar, ddof: ddof})
+}
+
+// StdDev returns rolling standard deviation of valid values in O(n) time.
+// It is the square root of Var.
+func (w Window[T]) StdDev(ddof int) Data[T] {
+	if ddof < 0 {
+		panic("ddof must be positive value!")
 	}
+	return w.roll(&rollingMoments[T]{stat: momentStd, ddof: ddof})
+}
+
+// Kurt returns rolling unbiased excess kurtosis of valid values in O(n) time.
+func (w Window[T]) Kurt() Data[T] {
+	return w.roll(&rollingMoments[T]{stat: momentKurt})
+}
+
+// Sem returns rolling standard error of the mean of valid values in O(n) time.
+// Ddof - Delta Degrees of Freedom. The divisor used in calculations is N - ddof,
+// where N represents the number of valid values.
+func (w Window[T]) Sem(ddof int) Data[T] {
+	if ddof < 0 {
+		panic("ddof must be positive value!")
+	}
+	return w.roll(&rollingMoments[T]{stat: momentSem, ddof: ddof})
+}
+
+// Count returns rolling count of valid values.
+func (w Window[T]) Count() Data[T] {
+	return w.roll(rollingCount[T]{})
+}
+
+// RankMethod is the rank of equal values.
+type RankMethod int
+
+const (
+	// RankAverage is the average rank of equal values.
+	RankAverage RankMethod = iota
+	// RankMin is the lowest rank of equal values.
+	RankMin
+	// RankMax is the highest rank of equal values.
+	RankMax
+)
+
+// Rank returns rank of the value of every row within its window in O(n log w) time.
+// Ranks start from 1 for the smallest value, pct divides ranks by count of valid values.
+// The row's value is ranked among the window values even if the window doesn't include it (ClosedLeft).
+// Rank of the NA value is NaN.
+func (w Window[T]) Rank(method RankMethod, pct bool) Data[T] {
+	if method < RankAverage || method > RankMax {
+		panic("method must be RankAverage, RankMin or RankMax")
+	}
+
+	kernel := &rollingSorted[T]{list: makeSkiplist[T](w.capacity())}
+
+	return w.rollCurrent(kernel, func(x T, count int, inside bool) T {
+		if !inside {
+			kernel.list.Insert(x)
+			defer kernel.list.Remove(x)
+		}
+
+		less, equal := kernel.list.Rank(x)
 
-	w.data.RollData(period, func(l int, r int) {
-		slice := w.data.Slice(l, r)
-		values[r-1] = agg(slice)
+		var rank T
+		switch method {
+		case RankMin:
+			rank = T(less + 1)
+		case RankMax:
+			rank = T(less + equal)
+		default:
+			rank = T(less) + T(equal+1)/2
+		}
+
+		if pct {
+			return rank / T(kernel.list.Len())
+		}
+		return rank
 	})
+}
+
+// Zscore returns rolling z-score (x - mean) / std of the value of every row in O(n) time,
+// the mean and std are taken from the row's window.
+// Ddof - Delta Degrees of Freedom of the standard deviation.
+// Z-score of the NA value or constant window is NaN.
+func (w Window[T]) Zscore(ddof int) Data[T] {
+	if ddof < 0 {
+		panic("ddof must be positive value!")
+	}
+
+	kernel := &rollingMoments[T]{stat: momentStd, ddof: ddof}
 
-	return clone
+	return w.rollCurrent(kernel, func(x T, count int, _ bool) T {
+		std := kernel.value(count)
+		if std == 0 {
+			return math.NaN[T]()
+		}
+		return (x - kernel.mean) / std
+	})
 }
 
-func (w Window) applyVar(varfn func(data Data, mean DType, ddof int) DType, ma Data, ddof int) Data {
+// rollCurrent applies fn to the value of the row of every window, the window is accumulated by kernel.
+// inside reports whether the window includes the row, it doesn't for left closed windows.
+// Results of rows with the NA value are NaN.
+func (w Window[T]) rollCurrent(kernel rollingKernel[T], fn func(x T, count int, inside bool) T) Data[T] {
 	var (
-		clone  = w.data.Clone()
-		values = clone.Values()
-		period = w.len
+		data   = w.data
+		out    = w.output()
+		values = out.values
+		step   = w.step()
 	)
 
-	total := period - 1
+	w.scan(kernel, func(i, l, r, count int) {
+		if !w.ready(l, r, count) || r == l || data.isNA(i) || data.values[i] != data.values[i] {
+			values[i/step] = math.NaN[T]()
+			return
+		}
+		values[i/step] = fn(data.values[i], count, l <= i && i < r)
+	})
+
+	return out.maskedLike(data)
+}
+
+// Cov returns rolling covariance with other series in O(n) time.
+// Only pairs of valid values are taken, so windows are ready by count of valid pairs.
+// Ddof - Delta Degrees of Freedom. The divisor used in calculations is N - ddof,
+// where N represents the number of pairs.
+func (w Window[T]) Cov(other Data[T], ddof int) Data[T] {
+	if ddof < 0 {
+		panic("ddof must be positive value!")
+	}
+	return w.pairwise(other).roll(&rollingCov[T]{y: other.values, ddof: ddof})
+}
 
-	for i := total; i < len(values); i++ {
-		p := i + 1
-		v := w.data.Slice(p-period, p)
-		values[i] = varfn(v, ma.values[p-1], ddof)
+// Corr returns rolling Pearson correlation with other series in O(n) time.
+// Only pairs of valid values are taken, so windows are ready by count of valid pairs.
+// Windows of constant values have NaN correlation.
+func (w Window[T]) Corr(other Data[T]) Data[T] {
+	return w.pairwise(other).roll(&rollingCov[T]{y: other.values, corr: true})
+}
+
+// pairwise returns the window over data values which are NA if other values are NA.
+func (w Window[T]) pairwise(other Data[T]) Window[T] {
+	if len(other.values) != len(w.data.values) {
+		panic("length of data and other must be equal")
 	}
 
-	for i := 0; i < total; i++ {
-		values[i] = math.NaN()
+	data := w.data.Clone()
+	for i := range other.values {
+		if other.isNA(i) && !data.isNA(i) {
+			data.SetNA(i)
+		}
 	}
 
-	return clone
+	w.data = data
+	return w
 }
 
-func (w Window) applyMedian() Data {
+// Apply applies custom aggregation function to every window.
+func (w Window[T]) Apply(agg AggregateFunc[T]) Data[T] {
 	var (
-		clone  = w.data.Clone()
-		values = clone.Values()
-		tmp    = make([]DType, 0, w.len)
-		period = w.len
+		out    = w.output()
+		values = out.values
+		step   = w.step()
 	)
 
-	for i := 0; i < w.len-1; i++ {
-		values[i] = math.NaN()
-	}
+	w.scan(rollingCount[T]{}, func(i, l, r, count int) {
+		if w.ready(l, r, count) {
+			values[i/step] = agg(w.data.Slice(l, r))
+		} else {
+			values[i/step] = math.NaN[T]()
+		}
+	})
+
+	return out.maskedLike(w.data)
+}
 
-	w.data.RollData(period, func(l int, r int) {
-		slice := w.data.Slice(l, r)
+// applyVar applies varfn to every window.
+// ma is the rolling mean of the same window, there is the mean for every window result.
+func (w Window[T]) applyVar(varfn func(data Data[T], mean T, ddof int) T, ma Data[T], ddof int) Data[T] {
+	var (
+		out    = w.output()
+		values = out.values
+		step   = w.step()
+	)
 
-		tmp = append(tmp[:0], slice.values...)
-		sort.Sort(DTypeSlice(tmp))
+	w.scan(rollingCount[T]{}, func(i, l, r, count int) {
+		j := i / step
+		if !w.ready(l, r, count) || (ddof > 0 && count <= ddof) {
+			values[j] = math.NaN[T]()
+			return
+		}
 
-		values[r-1] = Median(Data{values: tmp})
+		mean := ma.values[j]
+		if ma.isNA(j) {
+			mean = math.NaN[T]()
+		}
+		values[j] = varfn(w.data.Slice(l, r), mean, ddof)
 	})
 
-	return clone
+	return out.maskedLike(w.data)
 }
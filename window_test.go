@@ -6,30 +6,30 @@ import (
 	"github.com/WinPooh32/series/math"
 )
 
-var NaN = math.NaN()
+var NaN = math.NaN[float64]()
 
 func TestWindow_Sum(t *testing.T) {
 	type fields struct {
 		len  int
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"odd length",
 			fields{
 				len:  3,
-				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []DType{1, 2, 3, 4, 5}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{1, 2, 3, 4, 5}),
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5}, []DType{NaN, NaN, 6, 9, 12}),
+			MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{NaN, NaN, 6, 9, 12}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			w := Window{
+			w := Window[float64]{
 				len:  tt.fields.len,
 				data: tt.fields.data,
 			}
@@ -43,25 +43,25 @@ func TestWindow_Sum(t *testing.T) {
 func TestWindow_Mean(t *testing.T) {
 	type fields struct {
 		len  int
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"odd length",
 			fields{
 				len:  3,
-				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []DType{1, 2, 3, 4, 5}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{1, 2, 3, 4, 5}),
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5}, []DType{NaN, NaN, 2, 3, 4}),
+			MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{NaN, NaN, 2, 3, 4}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			w := Window{
+			w := Window[float64]{
 				len:  tt.fields.len,
 				data: tt.fields.data,
 			}
@@ -75,25 +75,25 @@ func TestWindow_Mean(t *testing.T) {
 func TestWindow_Min(t *testing.T) {
 	type fields struct {
 		len  int
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"odd length",
 			fields{
 				len:  3,
-				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []DType{4, 3, 5, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{4, 3, 5, 2, 6}),
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5}, []DType{NaN, NaN, 3, 2, 2}),
+			MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{NaN, NaN, 3, 2, 2}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			w := Window{
+			w := Window[float64]{
 				len:  tt.fields.len,
 				data: tt.fields.data,
 			}
@@ -107,25 +107,25 @@ func TestWindow_Min(t *testing.T) {
 func TestWindow_Max(t *testing.T) {
 	type fields struct {
 		len  int
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"odd length",
 			fields{
 				len:  3,
-				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []DType{4, 3, 5, 2, 6}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{4, 3, 5, 2, 6}),
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5}, []DType{NaN, NaN, 5, 5, 6}),
+			MakeData(1, []int64{1, 2, 3, 4, 5}, []float64{NaN, NaN, 5, 5, 6}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			w := Window{
+			w := Window[float64]{
 				len:  tt.fields.len,
 				data: tt.fields.data,
 			}
@@ -139,25 +139,25 @@ func TestWindow_Max(t *testing.T) {
 func TestWindow_Std(t *testing.T) {
 	type fields struct {
 		len  int
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"period = 3",
 			fields{
 				len:  3,
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []DType{5, 5, 6, 7, 5, 5, 5}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []float64{5, 5, 6, 7, 5, 5, 5}),
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []DType{NaN, NaN, 0.57735026, 1, 1, 1.1547005, 0}),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []float64{NaN, NaN, 0.57735026, 1, 1, 1.1547005, 0}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			w := Window{
+			w := Window[float64]{
 				len:  tt.fields.len,
 				data: tt.fields.data,
 			}
@@ -174,25 +174,25 @@ func TestWindow_Std(t *testing.T) {
 func TestWindow_Median(t *testing.T) {
 	type fields struct {
 		len  int
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"period = 3",
 			fields{
 				len:  3,
-				data: MakeData(1, []int64{0, 1, 2, 3, 4}, []DType{0, 1, 2, 3, 4}),
+				data: MakeData(1, []int64{0, 1, 2, 3, 4}, []float64{0, 1, 2, 3, 4}),
 			},
-			MakeData(1, []int64{0, 1, 2, 3, 4}, []DType{NaN, NaN, 1, 2, 3}),
+			MakeData(1, []int64{0, 1, 2, 3, 4}, []float64{NaN, NaN, 1, 2, 3}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			w := Window{
+			w := Window[float64]{
 				len:  tt.fields.len,
 				data: tt.fields.data,
 			}
@@ -206,25 +206,25 @@ func TestWindow_Median(t *testing.T) {
 func TestWindow_Variance(t *testing.T) {
 	type fields struct {
 		len  int
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"period = 3",
 			fields{
 				len:  3,
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []DType{5, 5, 6, 7, 5, 5, 5}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []float64{5, 5, 6, 7, 5, 5, 5}),
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []DType{NaN, NaN, 3.333333e-01, 1.000000e+00, 1.000000e+00, 1.333333e+00, 0}),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []float64{NaN, NaN, 3.333333e-01, 1.000000e+00, 1.000000e+00, 1.333333e+00, 0}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			w := Window{
+			w := Window[float64]{
 				len:  tt.fields.len,
 				data: tt.fields.data,
 			}
@@ -241,33 +241,33 @@ func TestWindow_Variance(t *testing.T) {
 func TestWindow_Skew(t *testing.T) {
 	type fields struct {
 		len  int
-		data Data
+		data Data[float64]
 	}
 	tests := []struct {
 		name   string
 		fields fields
-		want   Data
+		want   Data[float64]
 	}{
 		{
 			"period = 4",
 			fields{
 				len:  4,
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []DType{1, 1, 2, 2, 3, 3, 7}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []float64{1, 1, 2, 2, 3, 3, 7}),
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []DType{NaN, NaN, NaN, 0, 0, 0, 1.7198680301759643}),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []float64{NaN, NaN, NaN, 0, 0, 0, 1.7198680301759643}),
 		},
 		{
 			"period = 3",
 			fields{
 				len:  3,
-				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []DType{1, 1, 2, 2, 3, 3, 7}),
+				data: MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []float64{1, 1, 2, 2, 3, 3, 7}),
 			},
-			MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []DType{NaN, NaN, 1.7320508075688787, -1.7320508075688785, 1.732050807568875, -1.732050807568875, 1.7320508075688787}),
+			MakeData(1, []int64{1, 2, 3, 4, 5, 6, 7}, []float64{NaN, NaN, 1.7320508075688787, -1.7320508075688785, 1.732050807568875, -1.732050807568875, 1.7320508075688787}),
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			w := Window{
+			w := Window[float64]{
 				len:  tt.fields.len,
 				data: tt.fields.data,
 			}